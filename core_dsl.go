@@ -16,6 +16,7 @@ or by running 'ginkgo help'.
 package ginkgo
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"os"
@@ -41,6 +42,7 @@ var reporterConfig = types.NewDefaultReporterConfig()
 var suiteDidRun = false
 var outputInterceptor internal.OutputInterceptor
 var client parallel_support.Client
+var suiteContext context.Context
 
 func init() {
 	var err error
@@ -100,6 +102,28 @@ Ginkgo will cancel the SpecContext when a node is interrupted (e.g. by the user
 */
 type SpecContext = internal.SpecContext
 
+/*
+SpecRunner can be passed to RunSpecs to take over how each spec attempt is invoked - for example, to run specs inside a sandbox or to coordinate with external infrastructure.
+
+Ginkgo calls the SpecRunner with the spec's current report and a run function.  The SpecRunner must call run before returning - run executes the spec's setup, subject, and cleanup nodes and records failures, timings, and output on the spec report exactly as Ginkgo would without a SpecRunner.  A SpecRunner that returns without calling run causes the spec to fail.
+
+The SpecRunner is called once per attempt, so specs decorated with FlakeAttempts or MustPassRepeatedly will invoke it multiple times.
+
+RunSpecs accepts either a SpecRunner or a plain func(SpecReport, func()) literal.
+*/
+type SpecRunner = internal.SpecRunner
+
+/*
+SpecFilter can be passed to RunSpecs (or PreviewSpecs) to filter specs with arbitrary Go logic - for example, to only run database specs when a database is reachable.
+
+Ginkgo calls the SpecFilter once for each spec that survives the focus, skip, and label filters.  It is passed the spec's report before the spec has run: the container and leaf node texts, locations, and labels are populated.  Specs for which the SpecFilter returns false are skipped.
+
+The SpecFilter runs on every parallel process before specs are distributed so it must return the same answer for a given spec on every process.
+
+RunSpecs accepts either a SpecFilter or a plain func(SpecReport) bool literal.
+*/
+type SpecFilter = internal.SpecFilter
+
 /*
 GinkgoWriter implements a GinkgoWriterInterface and io.Writer
 
@@ -242,6 +266,12 @@ for setting these and orchestrating parallel specs across the parallel processes
 for more on how specs are parallelized in Ginkgo.
 
 You can also pass suite-level Label() decorators to RunSpecs.  The passed-in labels will apply to all specs in the suite.
+
+You can pass a SpecRunner to RunSpecs to take over how each spec attempt is invoked.  See the SpecRunner docs for details.
+
+You can pass a SpecFilter to RunSpecs to skip specs with arbitrary Go logic.  See the SpecFilter docs for details.
+
+Finally, you can pass a context.Context to RunSpecs.  When the context is cancelled, or its deadline passes, Ginkgo interrupts the suite: the running spec is interrupted (and its SpecContext is cancelled), the remaining specs are skipped, cleanup and reporting nodes run, and the suite fails with "Interrupted by Suite Context".
 */
 func RunSpecs(t GinkgoTestingT, description string, args ...interface{}) bool {
 	if suiteDidRun {
@@ -256,6 +286,13 @@ func RunSpecs(t GinkgoTestingT, description string, args ...interface{}) bool {
 
 	suiteLabels := extractSuiteConfiguration(args)
 
+	if suiteConfig.FailedSpecsFrom != "" && suiteConfig.ParallelProcess == 1 {
+		failedSpecs, err := types.LoadFailedSpecsReport(suiteConfig.FailedSpecsFrom)
+		if err != nil || len(failedSpecs) == 0 {
+			fmt.Fprintln(formatter.ColorableStdOut, formatter.F("{{orange}}Found no failed specs in %s - running all specs{{/}}", suiteConfig.FailedSpecsFrom))
+		}
+	}
+
 	var reporter reporters.Reporter
 	if suiteConfig.ParallelTotal == 1 {
 		reporter = reporters.NewDefaultReporter(reporterConfig, formatter.ColorableStdOut)
@@ -297,7 +334,12 @@ func RunSpecs(t GinkgoTestingT, description string, args ...interface{}) bool {
 	suitePath, err = filepath.Abs(suitePath)
 	exitIfErr(err)
 
-	passed, hasFocusedTests := global.Suite.Run(description, suiteLabels, suitePath, global.Failer, reporter, writer, outputInterceptor, interrupt_handler.NewInterruptHandler(client), client, internal.RegisterForProgressSignal, suiteConfig)
+	interruptHandler := interrupt_handler.NewInterruptHandler(client)
+	if suiteContext != nil {
+		interruptHandler.InterruptOnContextDone(suiteContext)
+	}
+
+	passed, hasFocusedTests := global.Suite.Run(description, suiteLabels, suitePath, global.Failer, reporter, writer, outputInterceptor, interruptHandler, client, internal.RegisterForProgressSignal, suiteConfig)
 	outputInterceptor.Shutdown()
 
 	flagSet.ValidateDeprecations(deprecationTracker)
@@ -327,6 +369,16 @@ func extractSuiteConfiguration(args []interface{}) Labels {
 			reporterConfig = arg
 		case Labels:
 			suiteLabels = append(suiteLabels, arg...)
+		case SpecRunner:
+			global.Suite.SetSpecRunner(arg)
+		case func(types.SpecReport, func()):
+			global.Suite.SetSpecRunner(SpecRunner(arg))
+		case SpecFilter:
+			global.Suite.SetSpecFilter(arg)
+		case func(types.SpecReport) bool:
+			global.Suite.SetSpecFilter(SpecFilter(arg))
+		case context.Context:
+			suiteContext = arg
 		default:
 			configErrors = append(configErrors, types.GinkgoErrors.UnknownTypePassedToRunSpecs(arg))
 		}
@@ -129,6 +129,15 @@ If the spec does not complete within the specified SpecTimeout the currently run
 */
 type SpecTimeout = internal.SpecTimeout
 
+/*
+CostHint allows you to specify an estimate of how long an individual spec takes to run.  CostHint can only decorate It nodes.
+
+When running in parallel Ginkgo hands out the heaviest groups of specs first so that long-running specs don't all end up at the tail end of the run on a single process.  Specs without a CostHint are treated as having no cost and keep their (randomized) order behind the specs that have one.
+
+CostHint does not affect the order specs run in when running in series.
+*/
+type CostHint = internal.CostHint
+
 /*
 GracePeriod denotes the period of time Ginkgo will wait for an interruptible node to exit once an interruption (whether due to a timeout or a user-invoked signal) has occurred.  If both the global --grace-period cli flag and a GracePeriod decorator are specified the value in the decorator will take precedence.
 
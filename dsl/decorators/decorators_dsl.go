@@ -25,6 +25,7 @@ type PollProgressInterval = ginkgo.PollProgressInterval
 type NodeTimeout = ginkgo.NodeTimeout
 type SpecTimeout = ginkgo.SpecTimeout
 type GracePeriod = ginkgo.GracePeriod
+type CostHint = ginkgo.CostHint
 
 const Focus = ginkgo.Focus
 const Pending = ginkgo.Pending
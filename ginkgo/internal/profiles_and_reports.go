@@ -96,6 +96,9 @@ func FinalizeProfilesAndReportsForSuites(suites TestSuites, cliConfig types.CLIC
 	if reporterConfig.TeamcityReport != "" {
 		reportFormats = append(reportFormats, reportFormat{ReportName: reporterConfig.TeamcityReport, GenerateFunc: reporters.GenerateTeamcityReport, MergeFunc: reporters.MergeAndCleanupTeamcityReports})
 	}
+	if reporterConfig.FailedSpecsReport != "" {
+		reportFormats = append(reportFormats, reportFormat{ReportName: reporterConfig.FailedSpecsReport, GenerateFunc: reporters.GenerateFailedSpecsReport, MergeFunc: reporters.MergeAndCleanupFailedSpecsReports})
+	}
 
 	// Generate reports for suites that failed to run
 	reportableSuites := suites.ThatAreGinkgoSuites()
@@ -122,6 +125,25 @@ func FinalizeProfilesAndReportsForSuites(suites TestSuites, cliConfig types.CLIC
 		}
 	}
 
+	// Recover partial JUnit reports for suites that exited before they could generate their report
+	if reporterConfig.JUnitReport != "" {
+		for _, suite := range reportableSuites.WithState(TestSuiteStateFailed) {
+			report := types.Report{
+				SuitePath:                  suite.AbsPath(),
+				SuiteConfig:                suiteConfig,
+				SuiteSucceeded:             false,
+				SpecialSuiteFailureReasons: []string{PARTIAL_REPORT_FAILURE_REASON},
+			}
+			dst := AbsPathForGeneratedAsset(reporterConfig.JUnitReport, suite, cliConfig, 0)
+			recovered, err := reporters.RecoverPartialJUnitReport(report, dst)
+			if err != nil {
+				messages = append(messages, fmt.Sprintf("Could not recover partial JUnit report for %s:\n%s", suite.PackageName, err.Error()))
+			} else if recovered {
+				messages = append(messages, fmt.Sprintf("Recovered a partial JUnit report for %s", suite.PackageName))
+			}
+		}
+	}
+
 	// Merge reports unless we've been asked to keep them separate
 	if !cliConfig.KeepSeparateReports {
 		for _, format := range reportFormats {
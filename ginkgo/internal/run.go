@@ -109,10 +109,15 @@ func runSerial(suite TestSuite, ginkgoConfig types.SuiteConfig, reporterConfig t
 	}
 	if reporterConfig.JUnitReport != "" {
 		reporterConfig.JUnitReport = AbsPathForGeneratedAsset(reporterConfig.JUnitReport, suite, cliConfig, 0)
+		reporterConfig.RecordPartialJUnitReport = true
+		reporters.CleanupPartialJUnitReports(reporterConfig.JUnitReport)
 	}
 	if reporterConfig.TeamcityReport != "" {
 		reporterConfig.TeamcityReport = AbsPathForGeneratedAsset(reporterConfig.TeamcityReport, suite, cliConfig, 0)
 	}
+	if reporterConfig.FailedSpecsReport != "" {
+		reporterConfig.FailedSpecsReport = AbsPathForGeneratedAsset(reporterConfig.FailedSpecsReport, suite, cliConfig, 0)
+	}
 
 	args, err := types.GenerateGinkgoTestRunArgs(ginkgoConfig, reporterConfig, goFlagsConfig)
 	command.AbortIfError("Failed to generate test run arguments", err)
@@ -181,10 +186,15 @@ func runParallel(suite TestSuite, ginkgoConfig types.SuiteConfig, reporterConfig
 	}
 	if reporterConfig.JUnitReport != "" {
 		reporterConfig.JUnitReport = AbsPathForGeneratedAsset(reporterConfig.JUnitReport, suite, cliConfig, 0)
+		reporterConfig.RecordPartialJUnitReport = true
+		reporters.CleanupPartialJUnitReports(reporterConfig.JUnitReport)
 	}
 	if reporterConfig.TeamcityReport != "" {
 		reporterConfig.TeamcityReport = AbsPathForGeneratedAsset(reporterConfig.TeamcityReport, suite, cliConfig, 0)
 	}
+	if reporterConfig.FailedSpecsReport != "" {
+		reporterConfig.FailedSpecsReport = AbsPathForGeneratedAsset(reporterConfig.FailedSpecsReport, suite, cliConfig, 0)
+	}
 
 	for proc := 1; proc <= numProcs; proc++ {
 		procGinkgoConfig := ginkgoConfig
@@ -16,6 +16,7 @@ import (
 const TIMEOUT_ELAPSED_FAILURE_REASON = "Suite did not run because the timeout elapsed"
 const PRIOR_FAILURES_FAILURE_REASON = "Suite did not run because prior suites failed and --keep-going is not set"
 const EMPTY_SKIP_FAILURE_REASON = "Suite did not run go test reported that no test files were found"
+const PARTIAL_REPORT_FAILURE_REASON = "Suite exited before it could generate its report - only the specs that completed are included"
 
 type TestSuiteState uint
 
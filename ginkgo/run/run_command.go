@@ -3,6 +3,7 @@ package run
 import (
 	"fmt"
 	"os"
+	"path/filepath"
 	"strings"
 	"time"
 
@@ -10,6 +11,7 @@ import (
 	"github.com/onsi/ginkgo/v2/ginkgo/command"
 	"github.com/onsi/ginkgo/v2/ginkgo/internal"
 	"github.com/onsi/ginkgo/v2/internal/interrupt_handler"
+	"github.com/onsi/ginkgo/v2/reporters"
 	"github.com/onsi/ginkgo/v2/types"
 )
 
@@ -90,15 +92,39 @@ func (r *SpecRunner) RunSpecs(args []string, additionalArgs []string) {
 		r.reporterConfig.Succinct = true
 	}
 
+	if r.suiteConfig.FailedSpecsFrom != "" {
+		// suites run in their own directories so they need an absolute path to the list of failed specs
+		r.suiteConfig.FailedSpecsFrom, _ = filepath.Abs(r.suiteConfig.FailedSpecsFrom)
+		failedSpecs, err := types.LoadFailedSpecsReport(r.suiteConfig.FailedSpecsFrom)
+		if err != nil || len(failedSpecs) == 0 {
+			fmt.Fprintln(formatter.ColorableStdOut, formatter.F("{{orange}}Found no failed specs in %s - running all specs{{/}}", r.suiteConfig.FailedSpecsFrom))
+			// we've already warned - don't have every suite warn again
+			r.suiteConfig.FailedSpecsFrom = ""
+		}
+	}
+
+	if r.suiteConfig.TimingProfileFrom != "" {
+		r.suiteConfig.TimingProfileFrom, _ = filepath.Abs(r.suiteConfig.TimingProfileFrom)
+		profile, err := reporters.LoadTimingProfile(r.suiteConfig.TimingProfileFrom)
+		if err != nil || len(profile) == 0 {
+			fmt.Fprintln(formatter.ColorableStdOut, formatter.F("{{orange}}Found no spec timings in %s - balancing specs by CostHint instead{{/}}", r.suiteConfig.TimingProfileFrom))
+		}
+	}
+
 	t := time.Now()
+	timeout := r.suiteConfig.Timeout
 	var endTime time.Time
-	if r.suiteConfig.Timeout > 0 {
-		endTime = t.Add(r.suiteConfig.Timeout)
+	if timeout > 0 {
+		endTime = t.Add(timeout)
 	}
 
 	iteration := 0
 OUTER_LOOP:
 	for {
+		if r.cliConfig.SoakDuration > 0 && timeout > 0 {
+			// each soak attempt gets its own --timeout budget
+			endTime = time.Now().Add(timeout)
+		}
 		if !r.flags.WasSet("seed") {
 			r.suiteConfig.RandomSeed = time.Now().Unix()
 		}
@@ -119,6 +145,9 @@ OUTER_LOOP:
 
 			if r.interruptHandler.Status().Interrupted() {
 				opc.StopAndDrain()
+				if r.cliConfig.SoakDuration > 0 {
+					fmt.Printf("Soak stopped after %d passing %s because it was interrupted\n", iteration, internal.PluralizedWord("attempt", "attempts", iteration))
+				}
 				break OUTER_LOOP
 			}
 
@@ -157,11 +186,25 @@ OUTER_LOOP:
 			if iteration > 0 {
 				fmt.Printf("\nTests failed on attempt #%d\n\n", iteration+1)
 			}
+			if r.cliConfig.SoakDuration > 0 {
+				reason := "tests failed"
+				if r.interruptHandler.Status().Interrupted() {
+					reason = "it was interrupted"
+				}
+				fmt.Printf("Soak stopped after %d passing %s because %s\n", iteration, internal.PluralizedWord("attempt", "attempts", iteration), reason)
+			}
 			break OUTER_LOOP
 		}
 
 		if r.cliConfig.UntilItFails {
 			fmt.Printf("\nAll tests passed...\nWill keep running them until they fail.\nThis was attempt #%d\n%s\n", iteration+1, orcMessage(iteration+1))
+		} else if r.cliConfig.SoakDuration > 0 {
+			if elapsed := time.Since(t); elapsed < r.cliConfig.SoakDuration {
+				fmt.Printf("\nAll tests passed...\nWill keep running them until they fail or the soak duration elapses (%s remaining).\nThis was attempt #%d\n", (r.cliConfig.SoakDuration - elapsed).Round(time.Second), iteration+1)
+			} else {
+				fmt.Printf("\nAll tests passed...\nSoak stopped after %d passing %s because the soak duration of %s elapsed\n", iteration+1, internal.PluralizedWord("attempt", "attempts", iteration+1), r.cliConfig.SoakDuration)
+				break OUTER_LOOP
+			}
 		} else if r.cliConfig.Repeat > 0 && iteration < r.cliConfig.Repeat {
 			fmt.Printf("\nAll tests passed...\nThis was attempt %d of %d.\n", iteration+1, r.cliConfig.Repeat+1)
 		} else {
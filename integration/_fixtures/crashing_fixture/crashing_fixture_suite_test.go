@@ -0,0 +1,25 @@
+package crashing_fixture_test
+
+import (
+	"os"
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestCrashingFixture(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "CrashingFixture Suite")
+}
+
+var _ = Describe("top-level container", func() {
+	It("runs and passes", func() {})
+	It("runs and fails", func() {
+		Fail("boom")
+	})
+	It("crashes", func() {
+		os.Exit(3)
+	})
+	It("never runs", func() {})
+})
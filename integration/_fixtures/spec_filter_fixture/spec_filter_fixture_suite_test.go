@@ -0,0 +1,21 @@
+package spec_filter_fixture_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+)
+
+func TestSpecFilterFixture(t *testing.T) {
+	RunSpecs(t, "SpecFilterFixture Suite", func(report SpecReport) bool {
+		return report.LeafNodeText != "is filtered out"
+	})
+}
+
+var _ = Describe("specs filtered by a plain func literal", func() {
+	It("runs", func() {})
+
+	It("is filtered out", func() {
+		Fail("SHOULD NOT RUN")
+	})
+})
@@ -0,0 +1,39 @@
+package spec_runner_fixture_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var runnerCalls = map[string]int{}
+var inRunner bool
+
+func TestSpecRunnerFixture(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "SpecRunnerFixture Suite", func(report SpecReport, run func()) {
+		runnerCalls[report.LeafNodeText] += 1
+		inRunner = true
+		defer func() { inRunner = false }()
+		run()
+	})
+}
+
+var _ = Describe("specs run by a plain func literal", func() {
+	It("runs inside the runner", func() {
+		Ω(inRunner).Should(BeTrue())
+	})
+
+	It("runs each attempt inside the runner", FlakeAttempts(2), func() {
+		Ω(inRunner).Should(BeTrue())
+		Ω(runnerCalls["runs each attempt inside the runner"]).Should(Equal(2))
+	})
+})
+
+var _ = AfterSuite(func() {
+	Ω(runnerCalls).Should(Equal(map[string]int{
+		"runs inside the runner":              1,
+		"runs each attempt inside the runner": 2,
+	}))
+})
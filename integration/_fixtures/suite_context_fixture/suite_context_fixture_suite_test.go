@@ -0,0 +1,35 @@
+package suite_context_fixture_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestSuiteContextFixture(t *testing.T) {
+	RegisterFailHandler(Fail)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	RunSpecs(t, "SuiteContextFixture Suite", ctx)
+}
+
+var _ = Describe("top-level container", Ordered, func() {
+	It("runs and passes", func() {})
+	It("is interrupted when the context is done", func(ctx SpecContext) {
+		<-ctx.Done()
+	})
+	It("never runs", func() {
+		time.Sleep(time.Hour)
+		Fail("SHOULD NOT SEE THIS")
+	})
+	AfterAll(func() {
+		GinkgoWriter.Println("Cleaning up")
+	})
+})
+
+var _ = ReportAfterSuite("reporting", func(report Report) {
+	GinkgoWriter.Println("Reporting at the end")
+})
@@ -0,0 +1,47 @@
+package integration_test
+
+import (
+	"os/exec"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+)
+
+var _ = Describe("Failed Specs Reports", func() {
+	BeforeEach(func() {
+		fm.MountFixture("passing_ginkgo_tests")
+		fm.MountFixture("failing_ginkgo_tests")
+	})
+
+	It("records the failed specs across suites and reruns only those specs", func() {
+		session := startGinkgo(fm.TmpDir, "--no-color", "--keep-going", "--failed-specs-report=failed.txt", "-r")
+		Eventually(session).Should(gexec.Exit(1))
+		Ω(fm.ContentOf("", "failed.txt")).Should(Equal("FailingGinkgoTests should fail\n"))
+		Ω(fm.PathTo("failing_ginkgo_tests", "failed.txt")).ShouldNot(BeAnExistingFile())
+
+		session = startGinkgo(fm.TmpDir, "--no-color", "--keep-going", "--failed-specs-from=failed.txt", "-r", "--procs=2")
+		Eventually(session).Should(gexec.Exit(1))
+		Ω(session).Should(gbytes.Say(`Failing_ginkgo_tests Suite - 1/2 specs`))
+		Ω(session).Should(gbytes.Say(`Passing_ginkgo_tests Suite - 0/5 specs`))
+	})
+
+	It("warns and runs all specs when there are no failed specs to rerun", func() {
+		session := startGinkgo(fm.PathTo("failing_ginkgo_tests"), "--no-color", "--failed-specs-from=missing.txt")
+		Eventually(session).Should(gexec.Exit(1))
+		Ω(session).Should(gbytes.Say(`Found no failed specs in .*missing.txt - running all specs`))
+		Ω(session).Should(gbytes.Say(`Will run 2 of 2 specs`))
+		Ω(session).ShouldNot(gbytes.Say(`Found no failed specs`))
+	})
+
+	It("warns and runs all specs under go test too", func() {
+		cmd := exec.Command("go", "test", "-ginkgo.no-color", "-ginkgo.failed-specs-from=missing.txt")
+		cmd.Dir = fm.PathTo("failing_ginkgo_tests")
+		session, err := gexec.Start(cmd, GinkgoWriter, GinkgoWriter)
+		Ω(err).ShouldNot(HaveOccurred())
+		Eventually(session).Should(gexec.Exit(1))
+		Ω(session).Should(gbytes.Say(`Found no failed specs in missing.txt - running all specs`))
+		Ω(session).Should(gbytes.Say(`Will run 2 of 2 specs`))
+	})
+})
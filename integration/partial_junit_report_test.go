@@ -0,0 +1,49 @@
+package integration_test
+
+import (
+	"os/exec"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+)
+
+var _ = Describe("Partial JUnit Reports", func() {
+	BeforeEach(func() {
+		fm.MountFixture("crashing")
+	})
+
+	DescribeTable("recovering the specs that completed when a suite crashes",
+		func(args ...string) {
+			session := startGinkgo(fm.PathTo("crashing"), append([]string{"--no-color", "--junit-report=out.xml"}, args...)...)
+			Eventually(session).Should(gexec.Exit(1))
+			Ω(session).Should(gbytes.Say("Recovered a partial JUnit report for crashing"))
+
+			report := fm.LoadJUnitReport("crashing", "out.xml")
+			Ω(report.Failures).Should(Equal(1))
+			suite := report.TestSuites[0]
+			Ω(suite.Properties.WithName("SuiteSucceeded")).Should(Equal("false"))
+			Ω(suite.Properties.WithName("SpecialSuiteFailureReason")).Should(ContainSubstring("Suite exited before it could generate its report"))
+			names := []string{}
+			for _, testCase := range suite.TestCases {
+				names = append(names, testCase.Name)
+			}
+			Ω(names).Should(ContainElements("[It] top-level container runs and passes", "[It] top-level container runs and fails"))
+			Ω(names).ShouldNot(ContainElement("[It] top-level container crashes"))
+
+			Ω(fm.PathTo("crashing", "out.xml.partial-1")).ShouldNot(BeAnExistingFile())
+		},
+		Entry("when running in series"),
+		Entry("when running in parallel", "--procs=2"),
+	)
+
+	It("does not record partial reports under go test, since only the CLI can recover them", func() {
+		cmd := exec.Command("go", "test", "-ginkgo.no-color", "-ginkgo.junit-report=out.xml")
+		cmd.Dir = fm.PathTo("crashing")
+		session, err := gexec.Start(cmd, GinkgoWriter, GinkgoWriter)
+		Ω(err).ShouldNot(HaveOccurred())
+		Eventually(session).Should(gexec.Exit(1))
+		Ω(fm.PathTo("crashing", "out.xml.partial-1")).ShouldNot(BeAnExistingFile())
+	})
+})
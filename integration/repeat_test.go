@@ -99,6 +99,58 @@ var _ = Describe("Repeat", func() {
 		})
 	})
 
+	Context("when told to --soak-duration", func() {
+		BeforeEach(func() {
+			fm.MountFixture("eventually_failing")
+		})
+
+		Context("when the tests fail before the duration elapses", func() {
+			It("should report failure and stop running", func() {
+				session := startGinkgo(fm.PathTo("eventually_failing"), "--soak-duration=1h", "--no-color")
+				Eventually(session).Should(gexec.Exit(1))
+				Ω(session).Should(gbytes.Say(`Will keep running them until they fail or the soak duration elapses \(.* remaining\)`))
+				Ω(session).Should(gbytes.Say("This was attempt #1"))
+				Ω(session).Should(gbytes.Say("This was attempt #2"))
+				Ω(session).Should(gbytes.Say("Tests failed on attempt #3"))
+				Ω(session).Should(gbytes.Say("Soak stopped after 2 passing attempts because tests failed"))
+			})
+		})
+
+		Context("when the duration elapses", func() {
+			It("should stop running and report success", func() {
+				session := startGinkgo(fm.PathTo("eventually_failing"), "--soak-duration=1s", "--no-color")
+				Eventually(session).Should(gexec.Exit(0))
+				Ω(session).Should(gbytes.Say(`Soak stopped after \d+ passing attempts? because the soak duration of 1s elapsed`))
+			})
+		})
+
+		Context("when the duration is longer than --timeout", func() {
+			It("gives each attempt its own timeout and reports success", func() {
+				fm.MountFixture("passing_ginkgo_tests")
+				session := startGinkgo(fm.PathTo("passing_ginkgo_tests"), "--soak-duration=6s", "--timeout=3s", "--no-color")
+				Eventually(session).Should(gexec.Exit(0))
+				Ω(session).Should(gbytes.Say(`Soak stopped after \d+ passing attempts because the soak duration of 6s elapsed`))
+			})
+		})
+
+		Context("when the soak is interrupted", func() {
+			It("reports that it was interrupted", func() {
+				fm.MountFixture("passing_ginkgo_tests")
+				session := startGinkgo(fm.PathTo("passing_ginkgo_tests"), "--soak-duration=1h", "--no-color")
+				Eventually(session).Should(gbytes.Say("This was attempt #1"))
+				session.Interrupt()
+				Eventually(session).Should(gexec.Exit())
+				Ω(session).Should(gbytes.Say(`Soak stopped after \d+ passing attempts? because it was interrupted`))
+			})
+		})
+
+		It("errors out early if --repeat or --until-it-fails are also set", func() {
+			session := startGinkgo(fm.PathTo("eventually_failing"), "--soak-duration=1h", "--until-it-fails", "--no-color")
+			Eventually(session).Should(gexec.Exit(1))
+			Ω(session.Err).Should(gbytes.Say("--soak-duration is set alongside --repeat or --until-it-fails"))
+		})
+	})
+
 	Context("if MustPassRepeatedly is set at suite config level", func() {
 		BeforeEach(func() {
 			fm.MountFixture("config_override_must_pass_repeatedly")
@@ -0,0 +1,21 @@
+package integration_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+)
+
+var _ = Describe("SpecFilter", func() {
+	BeforeEach(func() {
+		fm.MountFixture("spec_filter")
+	})
+
+	It("accepts a plain func(SpecReport) bool passed to RunSpecs and skips the specs it rejects", func() {
+		session := startGinkgo(fm.PathTo("spec_filter"), "--no-color")
+		Eventually(session).Should(gexec.Exit(0))
+		Ω(session).Should(gbytes.Say("Ran 1 of 2 Specs"))
+		Ω(session).Should(gbytes.Say("1 Skipped"))
+	})
+})
@@ -0,0 +1,20 @@
+package integration_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+)
+
+var _ = Describe("SpecRunner", func() {
+	BeforeEach(func() {
+		fm.MountFixture("spec_runner")
+	})
+
+	It("accepts a plain func(SpecReport, func()) passed to RunSpecs and runs every attempt through it", func() {
+		session := startGinkgo(fm.PathTo("spec_runner"), "--no-color")
+		Eventually(session).Should(gexec.Exit(0))
+		Ω(session).Should(gbytes.Say("Ran 2 of 2 Specs"))
+	})
+})
@@ -0,0 +1,34 @@
+package integration_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+
+	"github.com/onsi/ginkgo/v2/internal/interrupt_handler"
+	. "github.com/onsi/ginkgo/v2/internal/test_helpers"
+)
+
+var _ = Describe("Suite Context", func() {
+	var session *gexec.Session
+	BeforeEach(func() {
+		fm.MountFixture("suite_context")
+		session = startGinkgo(fm.PathTo("suite_context"), "--no-color", "-v", "--json-report=out.json")
+		Eventually(session).Should(gexec.Exit(1))
+	})
+
+	It("interrupts the suite when the context passed to RunSpecs is done", func() {
+		Ω(session).Should(gbytes.Say("Cleaning up"))
+		Ω(session).Should(gbytes.Say("Reporting at the end"))
+		Ω(session).Should(gbytes.Say("FAIL! - Interrupted by Suite Context"))
+		Ω(string(session.Out.Contents())).ShouldNot(ContainSubstring("SHOULD NOT SEE THIS"))
+
+		report := fm.LoadJSONReports("suite_context", "out.json")[0]
+		specs := Reports(report.SpecReports)
+		Ω(specs.Find("runs and passes")).Should(HavePassed())
+		Ω(specs.Find("is interrupted when the context is done")).Should(HaveBeenInterrupted(interrupt_handler.InterruptCauseContextDone))
+		Ω(specs.Find("never runs")).Should(HaveBeenSkipped())
+		Ω(report.SpecialSuiteFailureReasons).Should(ContainElement("Interrupted by Suite Context"))
+	})
+})
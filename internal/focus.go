@@ -49,10 +49,11 @@ It also supports focussing specs using regular expressions on the command line (
 
 When both programmatic and file filters are provided their results are ANDed together.  If multiple kinds of filters are provided, the file filters run first followed by the regex filters.
 
-This function sets the `Skip` property on specs by applying Ginkgo's focus policy:
+This function sets the `Skip` property (and the corresponding `SkipReason`) on specs by applying Ginkgo's focus policy:
 - If there are no CLI arguments and no programmatic focus, do nothing.
 - If a spec somewhere has programmatic focus skip any specs that have no programmatic focus.
 - If there are CLI arguments parse them and skip any specs that either don't match the focus filters or do match the skip filters.
+- If a failed specs report is provided via --failed-specs-from skip any specs that are not listed in it.
 
 *Note:* specs with pending nodes are Skipped when created by NewSpec.
 */
@@ -60,10 +61,13 @@ func ApplyFocusToSpecs(specs Specs, description string, suiteLabels Labels, suit
 	focusString := strings.Join(suiteConfig.FocusStrings, "|")
 	skipString := strings.Join(suiteConfig.SkipStrings, "|")
 
-	type SkipCheck func(spec Spec) bool
+	type SkipCheck struct {
+		reason     string
+		shouldSkip func(spec Spec) bool
+	}
 
 	// by default, skip any specs marked pending
-	skipChecks := []SkipCheck{func(spec Spec) bool { return spec.Nodes.HasNodeMarkedPending() }}
+	skipChecks := []SkipCheck{{"marked pending", func(spec Spec) bool { return spec.Nodes.HasNodeMarkedPending() }}}
 	hasProgrammaticFocus := false
 
 	for _, spec := range specs {
@@ -74,44 +78,56 @@ func ApplyFocusToSpecs(specs Specs, description string, suiteLabels Labels, suit
 	}
 
 	if hasProgrammaticFocus {
-		skipChecks = append(skipChecks, func(spec Spec) bool { return !spec.Nodes.HasNodeMarkedFocus() })
+		skipChecks = append(skipChecks, SkipCheck{"not programmatically focused", func(spec Spec) bool { return !spec.Nodes.HasNodeMarkedFocus() }})
 	}
 
 	if suiteConfig.LabelFilter != "" {
 		labelFilter, _ := types.ParseLabelFilter(suiteConfig.LabelFilter)
-		skipChecks = append(skipChecks, func(spec Spec) bool {
+		skipChecks = append(skipChecks, SkipCheck{"does not match --label-filter", func(spec Spec) bool {
 			return !labelFilter(UnionOfLabels(suiteLabels, spec.Nodes.UnionOfLabels()))
-		})
+		}})
 	}
 
 	if len(suiteConfig.FocusFiles) > 0 {
 		focusFilters, _ := types.ParseFileFilters(suiteConfig.FocusFiles)
-		skipChecks = append(skipChecks, func(spec Spec) bool { return !focusFilters.Matches(spec.Nodes.CodeLocations()) })
+		skipChecks = append(skipChecks, SkipCheck{"does not match --focus-file", func(spec Spec) bool { return !focusFilters.Matches(spec.Nodes.CodeLocations()) }})
 	}
 
 	if len(suiteConfig.SkipFiles) > 0 {
 		skipFilters, _ := types.ParseFileFilters(suiteConfig.SkipFiles)
-		skipChecks = append(skipChecks, func(spec Spec) bool { return skipFilters.Matches(spec.Nodes.CodeLocations()) })
+		skipChecks = append(skipChecks, SkipCheck{"matches --skip-file", func(spec Spec) bool { return skipFilters.Matches(spec.Nodes.CodeLocations()) }})
 	}
 
 	if focusString != "" {
 		// skip specs that don't match the focus string
 		re := regexp.MustCompile(focusString)
-		skipChecks = append(skipChecks, func(spec Spec) bool { return !re.MatchString(description + " " + spec.Text()) })
+		skipChecks = append(skipChecks, SkipCheck{"does not match --focus", func(spec Spec) bool { return !re.MatchString(description + " " + spec.Text()) }})
 	}
 
 	if skipString != "" {
 		// skip specs that match the skip string
 		re := regexp.MustCompile(skipString)
-		skipChecks = append(skipChecks, func(spec Spec) bool { return re.MatchString(description + " " + spec.Text()) })
+		skipChecks = append(skipChecks, SkipCheck{"matches --skip", func(spec Spec) bool { return re.MatchString(description + " " + spec.Text()) }})
+	}
+
+	if suiteConfig.FailedSpecsFrom != "" {
+		// skip specs that aren't listed in the failed specs report.  if the report is missing or empty we run everything - RunSpecs and the CLI warn the user when that happens.
+		failedSpecs, _ := types.LoadFailedSpecsReport(suiteConfig.FailedSpecsFrom)
+		if len(failedSpecs) > 0 {
+			isFailedSpec := map[string]bool{}
+			for _, text := range failedSpecs {
+				isFailedSpec[text] = true
+			}
+			skipChecks = append(skipChecks, SkipCheck{"not listed in --failed-specs-from", func(spec Spec) bool { return !isFailedSpec[types.FailedSpecsReportLine(spec.Text())] }})
+		}
 	}
 
 	// skip specs if shouldSkip() is true.  note that we do nothing if shouldSkip() is false to avoid overwriting skip status established by the node's pending status
 	processedSpecs := Specs{}
 	for _, spec := range specs {
 		for _, skipCheck := range skipChecks {
-			if skipCheck(spec) {
-				spec.Skip = true
+			if skipCheck.shouldSkip(spec) {
+				spec.Skip, spec.SkipReason = true, skipCheck.reason
 				break
 			}
 		}
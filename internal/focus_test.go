@@ -1,6 +1,9 @@
 package internal_test
 
 import (
+	"os"
+	"path/filepath"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
@@ -211,6 +214,38 @@ var _ = Describe("Focus", func() {
 			})
 		})
 
+		Context("when configured with a failed specs report", func() {
+			var reportPath string
+			BeforeEach(func() {
+				specs = Specs{
+					S(N("blue"), N("dragon")),
+					S(N("blue"), N("dragon"), N("hatchling")),
+					S(N("red dragon"), N()),
+					S(N(Pending), N("blue dragon")),
+				}
+				reportPath = filepath.Join(GinkgoT().TempDir(), "failed-specs.txt")
+				conf.FailedSpecsFrom = reportPath
+			})
+
+			It("only runs the specs listed in the report, and continues to skip specs with nodes marked pending", func() {
+				Ω(os.WriteFile(reportPath, []byte("blue dragon\nred dragon\n"), 0666)).Should(Succeed())
+				specs, hasProgrammaticFocus := internal.ApplyFocusToSpecs(specs, description, suiteLabels, conf)
+				Ω(harvestSkips(specs)).Should(Equal([]bool{false, true, false, true}))
+				Ω(hasProgrammaticFocus).Should(BeFalse())
+			})
+
+			It("runs all specs if the report is empty", func() {
+				Ω(os.WriteFile(reportPath, []byte{}, 0666)).Should(Succeed())
+				specs, _ := internal.ApplyFocusToSpecs(specs, description, suiteLabels, conf)
+				Ω(harvestSkips(specs)).Should(Equal([]bool{false, false, false, true}))
+			})
+
+			It("runs all specs if the report is missing", func() {
+				specs, _ := internal.ApplyFocusToSpecs(specs, description, suiteLabels, conf)
+				Ω(harvestSkips(specs)).Should(Equal([]bool{false, false, false, true}))
+			})
+		})
+
 		Context("when configured to focus/skip files", func() {
 			BeforeEach(func() {
 				specs = Specs{
@@ -293,6 +328,23 @@ var _ = Describe("Focus", func() {
 				Ω(harvestSkips(specs)).Should(Equal([]bool{false, true, true, true, true, true, true}))
 				Ω(hasProgrammaticFocus).Should(BeFalse())
 			})
+
+			It("records the first filter that caused each spec to be skipped", func() {
+				specs, _ := internal.ApplyFocusToSpecs(specs, description, suiteLabels, conf)
+				reasons := []string{}
+				for _, spec := range specs {
+					reasons = append(reasons, spec.SkipReason)
+				}
+				Ω(reasons).Should(Equal([]string{
+					"",
+					"does not match --label-filter",
+					"matches --skip",
+					"matches --skip-file",
+					"marked pending",
+					"does not match --focus-file",
+					"does not match --focus-file",
+				}))
+			})
 		})
 
 		Context("when configured with focus/skip files, focus/skip strings, and label filters and there is a programmatic focus", func() {
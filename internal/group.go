@@ -125,41 +125,57 @@ func (g *group) initialReportForSpec(spec Spec) types.SpecReport {
 		IsInOrderedContainer:        !spec.Nodes.FirstNodeMarkedOrdered().IsZero(),
 		MaxFlakeAttempts:            spec.Nodes.GetMaxFlakeAttempts(),
 		MaxMustPassRepeatedly:       spec.Nodes.GetMaxMustPassRepeatedly(),
+		PhaseLabel:                  g.suite.currentPhaseLabel,
 	}
 }
 
-func (g *group) evaluateSkipStatus(spec Spec) (types.SpecState, types.Failure) {
+// evaluateSkipStatus returns a skip reason for specs that are skipped without a failure that explains why
+func (g *group) evaluateSkipStatus(spec Spec) (types.SpecState, types.Failure, string) {
 	if spec.Nodes.HasNodeMarkedPending() {
-		return types.SpecStatePending, types.Failure{}
+		return types.SpecStatePending, types.Failure{}, ""
 	}
 	if spec.Skip {
-		return types.SpecStateSkipped, types.Failure{}
+		return types.SpecStateSkipped, types.Failure{}, spec.SkipReason
 	}
-	if g.suite.interruptHandler.Status().Interrupted() || g.suite.skipAll {
-		return types.SpecStateSkipped, types.Failure{}
+	if interruptStatus := g.suite.interruptHandler.Status(); interruptStatus.Interrupted() {
+		return types.SpecStateSkipped, types.Failure{}, "the suite was interrupted (" + interruptStatus.Cause.String() + ")"
+	}
+	if g.suite.skipAll {
+		return types.SpecStateSkipped, types.Failure{}, g.suite.skipAllReason
 	}
 	if !g.suite.deadline.IsZero() && g.suite.deadline.Before(time.Now()) {
-		return types.SpecStateSkipped, types.Failure{}
+		return types.SpecStateSkipped, types.Failure{}, "the suite timed out"
+	}
+	if g.suite.skipLaterPhases {
+		return types.SpecStateSkipped, g.suite.failureForLeafNodeWithMessage(spec.FirstNodeWithType(types.NodeTypeIt),
+			"Spec skipped because an earlier phase failed"), ""
 	}
 	if !g.succeeded && !g.continueOnFailure {
 		return types.SpecStateSkipped, g.suite.failureForLeafNodeWithMessage(spec.FirstNodeWithType(types.NodeTypeIt),
-			"Spec skipped because an earlier spec in an ordered container failed")
+			"Spec skipped because an earlier spec in an ordered container failed"), ""
+	}
+	if budget := g.suite.config.ContainerFailureBudget; budget > 0 {
+		container := spec.FirstNodeWithType(types.NodeTypeContainer)
+		if !container.IsZero() && g.suite.containerFailures[container.ID] >= budget {
+			return types.SpecStateSkipped, g.suite.failureForLeafNodeWithMessage(spec.FirstNodeWithType(types.NodeTypeIt),
+				fmt.Sprintf("Spec skipped because %d specs in \"%s\" have already failed (--container-failure-budget=%d)", g.suite.containerFailures[container.ID], container.Text, budget)), ""
+		}
 	}
 	if g.failedInARunOnceBefore && g.continueOnFailure {
 		return types.SpecStateSkipped, g.suite.failureForLeafNodeWithMessage(spec.FirstNodeWithType(types.NodeTypeIt),
-			"Spec skipped because a BeforeAll node failed")
+			"Spec skipped because a BeforeAll node failed"), ""
 	}
 	beforeOncePairs := g.runOncePairs[spec.SubjectID()].withType(types.NodeTypeBeforeAll | types.NodeTypeBeforeEach | types.NodeTypeJustBeforeEach)
 	for _, pair := range beforeOncePairs {
 		if g.runOnceTracker[pair].Is(types.SpecStateSkipped) {
 			return types.SpecStateSkipped, g.suite.failureForLeafNodeWithMessage(spec.FirstNodeWithType(types.NodeTypeIt),
-				fmt.Sprintf("Spec skipped because Skip() was called in %s", pair.nodeType))
+				fmt.Sprintf("Spec skipped because Skip() was called in %s", pair.nodeType)), ""
 		}
 	}
 	if g.suite.config.DryRun {
-		return types.SpecStatePassed, types.Failure{}
+		return types.SpecStatePassed, types.Failure{}, ""
 	}
-	return g.suite.currentSpecReport.State, g.suite.currentSpecReport.Failure
+	return g.suite.currentSpecReport.State, g.suite.currentSpecReport.Failure, ""
 }
 
 func (g *group) isLastSpecWithPair(specID uint, pair runOncePair) bool {
@@ -189,6 +205,8 @@ func (g *group) attemptSpec(isFinalAttempt bool, spec Spec) bool {
 	deadline := time.Time{}
 	if spec.SpecTimeout() > 0 {
 		deadline = time.Now().Add(spec.SpecTimeout())
+	} else if g.suite.config.DefaultSpecTimeout > 0 {
+		deadline = time.Now().Add(g.suite.config.DefaultSpecTimeout)
 	}
 
 	for _, node := range nodes {
@@ -298,6 +316,23 @@ func (g *group) attemptSpec(isFinalAttempt bool, spec Spec) bool {
 	return failedInARunOnceBefore
 }
 
+func (g *group) attemptSpecWithSpecRunner(isFinalAttempt bool, spec Spec) bool {
+	failedInARunOnceBefore, ran := false, false
+	g.suite.specRunner(g.suite.currentSpecReport, func() {
+		if ran {
+			return
+		}
+		ran = true
+		failedInARunOnceBefore = g.attemptSpec(isFinalAttempt, spec)
+	})
+	if !ran {
+		g.suite.currentSpecReport.State = types.SpecStateFailed
+		g.suite.currentSpecReport.Failure = g.suite.failureForLeafNodeWithMessage(spec.FirstNodeWithType(types.NodeTypeIt),
+			"The SpecRunner returned without running the spec")
+	}
+	return failedInARunOnceBefore
+}
+
 func (g *group) run(specs Specs) {
 	g.specs = specs
 	g.continueOnFailure = specs[0].Nodes.FirstNodeMarkedOrdered().MarkedContinueOnFailure
@@ -310,7 +345,7 @@ func (g *group) run(specs Specs) {
 		g.suite.currentSpecReport = g.initialReportForSpec(spec)
 		g.suite.selectiveLock.Unlock()
 
-		g.suite.currentSpecReport.State, g.suite.currentSpecReport.Failure = g.evaluateSkipStatus(spec)
+		g.suite.currentSpecReport.State, g.suite.currentSpecReport.Failure, g.suite.currentSpecReport.SkipReason = g.evaluateSkipStatus(spec)
 		g.suite.reporter.WillRun(g.suite.currentSpecReport)
 		g.suite.reportEach(spec, types.NodeTypeReportBeforeEach)
 
@@ -326,17 +361,21 @@ func (g *group) run(specs Specs) {
 				g.suite.currentSpecReport.MaxMustPassRepeatedly = maxAttempts
 			} else if g.suite.currentSpecReport.MaxMustPassRepeatedly > 0 {
 				maxAttempts = max(1, spec.MustPassRepeatedly())
+			} else if g.suite.currentSpecReport.MaxFlakeAttempts > 0 {
+				// a FlakeAttempts decorator takes precedence over the global --flake-attempts setting
+				maxAttempts = max(1, spec.FlakeAttempts())
 			} else if g.suite.config.FlakeAttempts > 0 {
 				maxAttempts = g.suite.config.FlakeAttempts
 				g.suite.currentSpecReport.MaxFlakeAttempts = maxAttempts
-			} else if g.suite.currentSpecReport.MaxFlakeAttempts > 0 {
-				maxAttempts = max(1, spec.FlakeAttempts())
 			}
 
+			interceptOutput := g.suite.shouldInterceptOutputFor(spec)
 			for attempt := 0; attempt < maxAttempts; attempt++ {
 				g.suite.currentSpecReport.NumAttempts = attempt + 1
 				g.suite.writer.Truncate()
-				g.suite.outputInterceptor.StartInterceptingOutput()
+				if interceptOutput {
+					g.suite.outputInterceptor.StartInterceptingOutput()
+				}
 				if attempt > 0 {
 					if g.suite.currentSpecReport.MaxMustPassRepeatedly > 0 {
 						g.suite.handleSpecEvent(types.SpecEvent{SpecEventType: types.SpecEventSpecRepeat, Attempt: attempt})
@@ -346,12 +385,18 @@ func (g *group) run(specs Specs) {
 					}
 				}
 
-				failedInARunOnceBefore = g.attemptSpec(attempt == maxAttempts-1, spec)
+				if g.suite.specRunner == nil {
+					failedInARunOnceBefore = g.attemptSpec(attempt == maxAttempts-1, spec)
+				} else {
+					failedInARunOnceBefore = g.attemptSpecWithSpecRunner(attempt == maxAttempts-1, spec)
+				}
 
 				g.suite.currentSpecReport.EndTime = time.Now()
 				g.suite.currentSpecReport.RunTime = g.suite.currentSpecReport.EndTime.Sub(g.suite.currentSpecReport.StartTime)
 				g.suite.currentSpecReport.CapturedGinkgoWriterOutput += string(g.suite.writer.Bytes())
-				g.suite.currentSpecReport.CapturedStdOutErr += g.suite.outputInterceptor.StopInterceptingAndReturnOutput()
+				if interceptOutput {
+					g.suite.currentSpecReport.CapturedStdOutErr += g.suite.outputInterceptor.StopInterceptingAndReturnOutput()
+				}
 
 				if g.suite.currentSpecReport.MaxMustPassRepeatedly > 0 {
 					if g.suite.currentSpecReport.State.Is(types.SpecStateFailureStates | types.SpecStateSkipped) {
@@ -375,6 +420,9 @@ func (g *group) run(specs Specs) {
 		if g.suite.currentSpecReport.State.Is(types.SpecStateFailureStates) {
 			g.succeeded = false
 			g.failedInARunOnceBefore = g.failedInARunOnceBefore || failedInARunOnceBefore
+			if container := spec.FirstNodeWithType(types.NodeTypeContainer); !container.IsZero() {
+				g.suite.containerFailures[container.ID] += 1
+			}
 		}
 		g.suite.selectiveLock.Lock()
 		g.suite.currentSpecReport = types.SpecReport{}
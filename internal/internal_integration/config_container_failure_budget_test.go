@@ -0,0 +1,62 @@
+package internal_integration_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/ginkgo/v2/internal/test_helpers"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("when config.ContainerFailureBudget is set", func() {
+	fixture := func() {
+		Describe("a broken feature", func() {
+			It("A", rt.T("A", func() { F("A failed") }))
+			Context("nested", func() {
+				It("B", rt.T("B", func() { F("B failed") }))
+			})
+			It("C", rt.T("C"))
+			It("D", rt.T("D", func() { F("D failed") }))
+		})
+		Describe("a working feature", func() {
+			It("E", rt.T("E"))
+			It("F", rt.T("F", func() { F("F failed") }))
+			It("G", rt.T("G"))
+		})
+		It("H", rt.T("H", func() { F("H failed") }))
+		It("I", rt.T("I", func() { F("I failed") }))
+	}
+
+	BeforeEach(func() {
+		conf.RandomizeAllSpecs = false
+	})
+
+	Context("with a budget", func() {
+		BeforeEach(func() {
+			conf.ContainerFailureBudget = 2
+			success, _ := RunFixture("container failure budget", fixture)
+			Ω(success).Should(BeFalse())
+		})
+
+		It("skips the remaining specs in a top-level container once its budget is exhausted", func() {
+			Ω(rt.TrackedRuns()).Should(ContainElements("A", "B"))
+			Ω(rt.TrackedRuns()).ShouldNot(ContainElements("C", "D"))
+			Ω(reporter.Did.Find("C")).Should(HaveBeenSkippedWithMessage(`Spec skipped because 2 specs in "a broken feature" have already failed (--container-failure-budget=2)`))
+			Ω(reporter.Did.Find("D")).Should(HaveBeenSkippedWithMessage(`Spec skipped because 2 specs in "a broken feature" have already failed (--container-failure-budget=2)`))
+		})
+
+		It("keeps running the specs in other containers and specs that are not in a container", func() {
+			Ω(rt.TrackedRuns()).Should(ContainElements("E", "F", "G", "H", "I"))
+			Ω(reporter.End).Should(BeASuiteSummary(false, NSpecs(9), NPassed(2), NFailed(5), NSkipped(2)))
+		})
+	})
+
+	Context("without a budget", func() {
+		BeforeEach(func() {
+			success, _ := RunFixture("container failure budget", fixture)
+			Ω(success).Should(BeFalse())
+		})
+
+		It("runs every spec", func() {
+			Ω(rt.TrackedRuns()).Should(ConsistOf("A", "B", "C", "D", "E", "F", "G", "H", "I"))
+		})
+	})
+})
@@ -0,0 +1,45 @@
+package internal_integration_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/ginkgo/v2/internal/test_helpers"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("when config.DefaultSpecTimeout is set", func() {
+	BeforeEach(func() {
+		conf.DefaultSpecTimeout = 50 * time.Millisecond
+		success, _ := RunFixture("default spec timeout", func() {
+			Describe("a container", func() {
+				It("A", rt.TSC("A", func(c SpecContext) {
+					<-c.Done()
+				}))
+				It("B", rt.T("B", func() {
+					time.Sleep(200 * time.Millisecond)
+				}))
+				It("C", rt.TSC("C", func(c SpecContext) {
+					select {
+					case <-c.Done():
+						F("cancelled early")
+					case <-time.After(100 * time.Millisecond):
+					}
+				}), SpecTimeout(time.Second))
+				It("D", rt.T("D"))
+			})
+		})
+		Ω(success).Should(BeFalse())
+	})
+
+	It("times out specs that run for longer than the default", func() {
+		Ω(rt).Should(HaveTracked("A", "B", "C", "D"))
+		Ω(reporter.Did.Find("A")).Should(HaveTimedOut("A spec timeout occurred"))
+		Ω(reporter.Did.Find("B")).Should(HaveTimedOut("A spec timeout occurred"))
+		Ω(reporter.Did.Find("D")).Should(HavePassed())
+	})
+
+	It("lets the SpecTimeout decorator take precedence", func() {
+		Ω(reporter.Did.Find("C")).Should(HavePassed())
+	})
+})
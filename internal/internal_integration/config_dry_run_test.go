@@ -65,3 +65,22 @@ var _ = Describe("when config.DryRun is enabled", func() {
 		Ω(reporter.End).Should(BeASuiteSummary(NSpecs(5), NPassed(3), NPending(1), NSkipped(1)))
 	})
 })
+
+var _ = Describe("when config.DryRun and config.FailOnPending are both enabled", func() {
+	BeforeEach(func() {
+		conf.DryRun = true
+		conf.FailOnPending = true
+
+		success, _ := RunFixture("dry run with pending specs", func() {
+			It("A", rt.T("A"))
+			PIt("B", rt.T("B"))
+		})
+		Ω(success).Should(BeTrue())
+	})
+
+	It("does not fail the suite", func() {
+		Ω(rt).Should(HaveTracked())
+		Ω(reporter.End).Should(BeASuiteSummary(true, NSpecs(2), NPassed(1), NPending(1)))
+		Ω(reporter.End.SpecialSuiteFailureReasons).Should(BeEmpty())
+	})
+})
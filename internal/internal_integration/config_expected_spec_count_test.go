@@ -0,0 +1,79 @@
+package internal_integration_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/ginkgo/v2/internal/test_helpers"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("when config.ExpectedSpecCount is set", func() {
+	var success bool
+	JustBeforeEach(func() {
+		success, _ = RunFixture("expected spec count", func() {
+			Describe("container", func() {
+				It("A", rt.T("A"))
+				It("B", rt.T("B"))
+				PIt("C", rt.T("C"))
+				It("D", rt.T("D"))
+			})
+		})
+	})
+
+	Context("and the suite has at least that many specs", func() {
+		BeforeEach(func() {
+			conf.ExpectedSpecCount = 4
+		})
+
+		It("succeeds", func() {
+			Ω(success).Should(BeTrue())
+			Ω(reporter.End.SpecialSuiteFailureReasons).Should(BeEmpty())
+		})
+	})
+
+	Context("and the suite has fewer specs than expected", func() {
+		BeforeEach(func() {
+			conf.ExpectedSpecCount = 6
+		})
+
+		It("still runs the specs, but fails the suite and explains why", func() {
+			Ω(rt).Should(HaveTracked("A", "B", "D"))
+			Ω(success).Should(BeFalse())
+			Ω(reporter.End).Should(BeASuiteSummary(false, NSpecs(4), NPassed(3), NPending(1)))
+			Ω(reporter.End.SpecialSuiteFailureReasons).Should(ConsistOf("Found 4 specs but --expected-spec-count is 6 (tolerance 0)"))
+		})
+
+		Context("but the shortfall is within the tolerance", func() {
+			BeforeEach(func() {
+				conf.ExpectedSpecCountTolerance = 2
+			})
+
+			It("succeeds", func() {
+				Ω(success).Should(BeTrue())
+				Ω(reporter.End.SpecialSuiteFailureReasons).Should(BeEmpty())
+			})
+		})
+
+		Context("and the shortfall exceeds the tolerance", func() {
+			BeforeEach(func() {
+				conf.ExpectedSpecCountTolerance = 1
+			})
+
+			It("fails the suite", func() {
+				Ω(success).Should(BeFalse())
+				Ω(reporter.End.SpecialSuiteFailureReasons).Should(ConsistOf("Found 4 specs but --expected-spec-count is 6 (tolerance 1)"))
+			})
+		})
+	})
+
+	Context("and specs are filtered out by focus", func() {
+		BeforeEach(func() {
+			conf.ExpectedSpecCount = 4
+			conf.FocusStrings = []string{"A"}
+		})
+
+		It("counts all the specs in the suite, not just those that will run", func() {
+			Ω(rt).Should(HaveTracked("A"))
+			Ω(success).Should(BeTrue())
+		})
+	})
+})
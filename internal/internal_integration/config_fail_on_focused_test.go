@@ -0,0 +1,41 @@
+package internal_integration_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/ginkgo/v2/internal/test_helpers"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("when config.FailOnFocused is set", func() {
+	BeforeEach(func() {
+		conf.FailOnFocused = true
+	})
+
+	Context("and the suite has programmatically focused specs", func() {
+		It("runs the focused specs, but fails the suite and explains why", func() {
+			success, hasProgrammaticFocus := RunFixture("fail on focused", func() {
+				FIt("A", rt.T("A"))
+				It("B", rt.T("B"))
+			})
+			Ω(success).Should(BeFalse())
+			Ω(hasProgrammaticFocus).Should(BeTrue())
+			Ω(rt).Should(HaveTracked("A"))
+			Ω(reporter.End).Should(BeASuiteSummary(false, NSpecs(2), NPassed(1), NSkipped(1)))
+			Ω(reporter.End.SpecialSuiteFailureReasons).Should(ConsistOf("Detected programmatic focus and --fail-on-focused is set"))
+		})
+	})
+
+	Context("and specs are only focused with --focus", func() {
+		It("succeeds", func() {
+			conf.FocusStrings = []string{"A"}
+			success, hasProgrammaticFocus := RunFixture("fail on focused with --focus", func() {
+				It("A", rt.T("A"))
+				It("B", rt.T("B"))
+			})
+			Ω(success).Should(BeTrue())
+			Ω(hasProgrammaticFocus).Should(BeFalse())
+			Ω(rt).Should(HaveTracked("A"))
+			Ω(reporter.End.SpecialSuiteFailureReasons).Should(BeEmpty())
+		})
+	})
+})
@@ -17,12 +17,13 @@ var _ = Describe("when config.FlakeAttempts is greater than 1", func() {
 		success, _ = RunFixture("flakey success", func() {
 			It("A", rt.T("A", func() {
 				counterA += 1
-				if counterA < 2 {
+				By(fmt.Sprintf("A - attempt #%d", counterA))
+				if counterA < 3 {
 					F(fmt.Sprintf("A - %d", counterA))
 				}
 			}))
 			It("B", func() {})
-			It("C", FlakeAttempts(1), rt.T("C", func() { //the config flag overwrites the individual test annotations
+			It("C", FlakeAttempts(3), rt.T("C", func() { //the individual test annotation takes precedence over the config flag
 				counterC += 1
 				By(fmt.Sprintf("C - attempt #%d", counterC))
 				if counterC < 3 {
@@ -43,7 +44,7 @@ var _ = Describe("when config.FlakeAttempts is greater than 1", func() {
 		})
 
 		It("reports that the test passed with the correct number of attempts", func() {
-			Ω(reporter.Did.Find("A")).Should(HavePassed(NumAttempts(2)))
+			Ω(reporter.Did.Find("A")).Should(HavePassed(NumAttempts(3)))
 			Ω(reporter.Did.Find("B")).Should(HavePassed(NumAttempts(1)))
 			Ω(reporter.Did.Find("C")).Should(HavePassed(NumAttempts(3)))
 			Ω(reporter.Did.Find("C").Timeline()).Should(BeTimelineContaining(
@@ -75,21 +76,64 @@ var _ = Describe("when config.FlakeAttempts is greater than 1", func() {
 		})
 
 		It("reports that the test failed with the correct number of attempts", func() {
-			Ω(reporter.Did.Find("A")).Should(HavePassed(NumAttempts(2)))
+			Ω(reporter.Did.Find("A")).Should(HaveFailed("A - 2", NumAttempts(2)))
 			Ω(reporter.Did.Find("B")).Should(HavePassed(NumAttempts(1)))
-			Ω(reporter.Did.Find("C")).Should(HaveFailed("C - 2", NumAttempts(2)))
-			Ω(reporter.Did.Find("C").Timeline()).Should(BeTimelineContaining(
-				BeSpecEvent(types.SpecEventByStart, "C - attempt #1"),
-				HaveFailed("C - 1"),
+			Ω(reporter.Did.Find("A").Timeline()).Should(BeTimelineContaining(
+				BeSpecEvent(types.SpecEventByStart, "A - attempt #1"),
+				HaveFailed("A - 1"),
 				BeSpecEvent(types.SpecEventSpecRetry, 1),
-				BeSpecEvent(types.SpecEventByStart, "C - attempt #2"),
+				BeSpecEvent(types.SpecEventByStart, "A - attempt #2"),
 			))
 		})
 
+		It("lets the individual test annotation take precedence over the config flag", func() {
+			Ω(reporter.Did.Find("C")).Should(HavePassed(NumAttempts(3)))
+			Ω(reporter.Did.Find("C").MaxFlakeAttempts).Should(Equal(3))
+		})
+
 		It("includes the intermediate failures as AdditionalFailure, but not the final failure (this allows timeline reconstruction)", func() {
-			Ω(reporter.Did.Find("C").AdditionalFailures).Should(HaveLen(1))
-			Ω(reporter.Did.Find("C").AdditionalFailures[0]).Should(HaveFailed("C - 1"))
+			Ω(reporter.Did.Find("A").AdditionalFailures).Should(HaveLen(1))
+			Ω(reporter.Did.Find("A").AdditionalFailures[0]).Should(HaveFailed("A - 1"))
+		})
+	})
+})
+
+var _ = Describe("when a FlakeAttempts decorator asks for fewer attempts than config.FlakeAttempts", func() {
+	BeforeEach(func() {
+		conf.FlakeAttempts = 3
+		var counter int
+		success, _ := RunFixture("decorator precedence", func() {
+			It("A", FlakeAttempts(1), rt.T("A", func() {
+				counter += 1
+				F(fmt.Sprintf("A - %d", counter))
+			}))
 		})
+		Ω(success).Should(BeFalse())
+	})
+
+	It("honors the decorator (earlier versions of Ginkgo let the config flag win)", func() {
+		Ω(reporter.Did.Find("A")).Should(HaveFailed("A - 1", NumAttempts(1)))
+		Ω(reporter.Did.Find("A").MaxFlakeAttempts).Should(Equal(1))
+	})
+})
+
+var _ = Describe("grouping the failures of a FlakeAttempts spec by message", func() {
+	BeforeEach(func() {
+		success, _ := RunFixture("flakey failure", func() {
+			It("A", FlakeAttempts(3), rt.T("A", func() {
+				F("boom")
+			}))
+		})
+		Ω(success).Should(BeFalse())
+	})
+
+	It("puts every attempt's failure in the same group", func() {
+		Ω(reporter.Did.Find("A")).Should(HaveFailed("boom", NumAttempts(3)))
+		Ω(reporter.Did.Find("A").AdditionalFailures).Should(HaveLen(2))
 
+		groups := reporter.End.SpecReports.GroupFailuresByMessage()
+		Ω(groups).Should(HaveLen(1))
+		Ω(groups[0].Message).Should(Equal("boom"))
+		Ω(groups[0].SpecReports).Should(HaveLen(1))
 	})
 })
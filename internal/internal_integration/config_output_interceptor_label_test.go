@@ -0,0 +1,34 @@
+package internal_integration_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/ginkgo/v2/internal/test_helpers"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("when config.OutputInterceptorLabel is set", func() {
+	BeforeEach(func() {
+		conf.OutputInterceptorLabel = "debug"
+		RunFixture("label-scoped output interception", func() {
+			Describe("a container", func() {
+				It("A", Label("Debug"), func() {
+					outputInterceptor.AppendInterceptedOutput("output-from-A")
+				})
+				It("B", func() {
+					outputInterceptor.AppendInterceptedOutput("output-from-B")
+				})
+				Describe("a debug container", Label("debug"), func() {
+					It("C", func() {
+						outputInterceptor.AppendInterceptedOutput("output-from-C")
+					})
+				})
+			})
+		})
+	})
+
+	It("only captures stdout/stderr for specs that have the label", func() {
+		Ω(reporter.Did.Find("A")).Should(HavePassed(CapturedStdOutput("output-from-A")))
+		Ω(reporter.Did.Find("B")).Should(HavePassed(CapturedStdOutput("")))
+		Ω(reporter.Did.Find("C")).Should(HavePassed(CapturedStdOutput("output-from-C")))
+	})
+})
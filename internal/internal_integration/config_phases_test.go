@@ -0,0 +1,107 @@
+package internal_integration_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/ginkgo/v2/internal/test_helpers"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("when config.PhaseLabels is set", func() {
+	var success bool
+	var failUnit bool
+	BeforeEach(func() {
+		failUnit = false
+		conf.PhaseLabels = []string{"unit", "integration"}
+	})
+
+	JustBeforeEach(func() {
+		success, _ = RunFixture("phases", func() {
+			Describe("integration container", Label("integration"), func() {
+				It("I1", rt.T("I1"))
+				It("I2", rt.T("I2"))
+			})
+			Describe("unit container", Label("unit"), func() {
+				It("U1", rt.T("U1"))
+				It("U2", rt.T("U2", func() {
+					if failUnit {
+						F("unit failure")
+					}
+				}))
+				It("U3", Label("integration"), rt.T("U3"))
+			})
+			Describe("unlabeled container", func() {
+				It("A", rt.T("A"))
+				It("B", Label("UNIT"), rt.T("B"))
+			})
+		})
+	})
+
+	Context("when every phase passes", func() {
+		It("runs the unlabeled specs first, then each phase in order", func() {
+			Ω(success).Should(BeTrue())
+			runs := rt.TrackedRuns()
+			Ω(runs).Should(HaveLen(7))
+			Ω(runs[0]).Should(Equal("A"))
+			Ω(runs[1:4]).Should(ConsistOf("U1", "U2", "B"))
+			Ω(runs[4:]).Should(ConsistOf("I1", "I2", "U3"))
+			Ω(reporter.End).Should(BeASuiteSummary(true, NSpecs(7), NPassed(7)))
+		})
+	})
+
+	Context("when an earlier phase fails", func() {
+		BeforeEach(func() {
+			failUnit = true
+		})
+
+		It("finishes the failing phase but skips the later phases", func() {
+			Ω(success).Should(BeFalse())
+			runs := rt.TrackedRuns()
+			Ω(runs).Should(HaveLen(4))
+			Ω(runs[0]).Should(Equal("A"))
+			Ω(runs[1:]).Should(ConsistOf("U1", "U2", "B"))
+			Ω(reporter.Did.Find("U2")).Should(HaveFailed("unit failure"))
+			Ω(reporter.Did.Find("I1")).Should(HaveBeenSkippedWithMessage("Spec skipped because an earlier phase failed"))
+			Ω(reporter.Did.Find("I2")).Should(HaveBeenSkippedWithMessage("Spec skipped because an earlier phase failed"))
+			Ω(reporter.Did.Find("U3")).Should(HaveBeenSkippedWithMessage("Spec skipped because an earlier phase failed"))
+			Ω(reporter.End).Should(BeASuiteSummary(false, NSpecs(7), NPassed(3), NFailed(1), NSkipped(3)))
+		})
+
+		Context("and config.PhaseContinueOnFailure is set", func() {
+			BeforeEach(func() {
+				conf.PhaseContinueOnFailure = true
+			})
+
+			It("runs the later phases anyway", func() {
+				Ω(success).Should(BeFalse())
+				Ω(rt.TrackedRuns()).Should(HaveLen(7))
+				Ω(rt.TrackedRuns()[4:]).Should(ConsistOf("I1", "I2", "U3"))
+				Ω(reporter.End).Should(BeASuiteSummary(false, NSpecs(7), NPassed(6), NFailed(1)))
+			})
+		})
+	})
+})
+
+var _ = Describe("when config.PhaseLabels is set and specs share an Ordered container", func() {
+	var success bool
+	BeforeEach(func() {
+		conf.PhaseLabels = []string{"unit", "integration"}
+		success, _ = RunFixture("phases with ordered containers", func() {
+			Describe("ordered container", Ordered, func() {
+				It("O1", rt.T("O1"))
+				It("O2", Label("integration"), rt.T("O2"))
+			})
+			It("U", Label("unit"), rt.T("U"))
+			It("A", rt.T("A"))
+		})
+	})
+
+	It("runs the whole container in its latest phase and reports every spec under that phase", func() {
+		Ω(success).Should(BeTrue())
+		Ω(rt.TrackedRuns()).Should(Equal([]string{"A", "U", "O1", "O2"}))
+		Ω(reporter.Did.Find("O1").PhaseLabel).Should(Equal("integration"))
+		Ω(reporter.Did.Find("O2").PhaseLabel).Should(Equal("integration"))
+		Ω(reporter.Did.Find("U").PhaseLabel).Should(Equal("unit"))
+		Ω(reporter.Did.Find("A").PhaseLabel).Should(Equal(""))
+		Ω(reporter.Did.Find("O1").Phase(conf.PhaseLabels)).Should(Equal("integration"))
+	})
+})
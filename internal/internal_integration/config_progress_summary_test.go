@@ -0,0 +1,94 @@
+package internal_integration_test
+
+import (
+	"sort"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/ginkgo/v2/internal/test_helpers"
+	"github.com/onsi/ginkgo/v2/types"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("when config.ProgressSummaryInterval is set", func() {
+	fixture := func() {
+		Describe("a container", func() {
+			It("A", rt.T("A"))
+			It("B", rt.T("B", func() { time.Sleep(20 * time.Millisecond) }))
+			It("C", rt.T("C", func() { F() }))
+			PIt("D", rt.T("D"))
+		})
+	}
+
+	Context("with a short interval", func() {
+		BeforeEach(func() {
+			conf.ProgressSummaryInterval = time.Nanosecond
+			RunFixture("progress summary", fixture)
+		})
+
+		It("emits a partial report each time a spec completes", func() {
+			// summaries are also emitted on every tick so we only look at the first summary for each number of completed specs
+			summaries := map[int]types.Report{}
+			numCompleted := []int{}
+			for _, summary := range reporter.ProgressSummaries {
+				numCompleted = append(numCompleted, len(summary.SpecReports))
+				if _, ok := summaries[len(summary.SpecReports)]; !ok {
+					summaries[len(summary.SpecReports)] = summary
+				}
+			}
+			Ω(sort.IntsAreSorted(numCompleted)).Should(BeTrue())
+			Ω(summaries).Should(HaveKey(1))
+			Ω(summaries).Should(HaveKey(2))
+			Ω(summaries).Should(HaveKey(3))
+			Ω(summaries).Should(HaveKey(4))
+			Ω(summaries[1].PreRunStats.SpecsThatWillRun).Should(Equal(3))
+			Ω(summaries[2].RunTime).Should(BeNumerically(">=", 20*time.Millisecond))
+			Ω(summaries[3].SpecReports.CountWithState(types.SpecStateFailed)).Should(Equal(1))
+		})
+
+		It("still emits the authoritative summary at the end", func() {
+			Ω(reporter.End).Should(BeASuiteSummary(false, NSpecs(4), NPassed(2), NFailed(1), NPending(1)))
+		})
+	})
+
+	Context("when a spec runs for longer than the interval", func() {
+		BeforeEach(func() {
+			conf.ProgressSummaryInterval = 20 * time.Millisecond
+			RunFixture("progress summary with a slow spec", func() {
+				It("A", rt.T("A", func() { time.Sleep(200 * time.Millisecond) }))
+				It("B", rt.T("B"))
+			})
+		})
+
+		It("keeps emitting summaries while the spec is running", func() {
+			numWhileARan := 0
+			for _, summary := range reporter.ProgressSummaries {
+				if len(summary.SpecReports) == 0 {
+					numWhileARan += 1
+				}
+			}
+			Ω(numWhileARan).Should(BeNumerically(">=", 2))
+		})
+	})
+
+	Context("with an interval longer than the suite", func() {
+		BeforeEach(func() {
+			conf.ProgressSummaryInterval = time.Hour
+			RunFixture("progress summary", fixture)
+		})
+
+		It("does not emit any progress summaries", func() {
+			Ω(reporter.ProgressSummaries).Should(BeEmpty())
+		})
+	})
+
+	Context("when the interval is not set", func() {
+		BeforeEach(func() {
+			RunFixture("progress summary", fixture)
+		})
+
+		It("does not emit any progress summaries", func() {
+			Ω(reporter.ProgressSummaries).Should(BeEmpty())
+		})
+	})
+})
@@ -0,0 +1,72 @@
+package internal_integration_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/ginkgo/v2/internal/test_helpers"
+	"github.com/onsi/ginkgo/v2/types"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("when config.RedactOutputPatterns is set", func() {
+	var reportAfterEachOutput []string
+
+	BeforeEach(func() {
+		reportAfterEachOutput = []string{}
+		conf.RedactOutputPatterns = []string{`token=\w+`, `password: \S+`}
+		RunFixture("redacted output", func() {
+			Describe("a container", func() {
+				It("A", func() {
+					writer.Print("logging in with token=abc123\n")
+					By("checking the password")
+					writer.Print("password: hunter2\n")
+					F("boom")
+				})
+
+				attempt := 0
+				It("B", func() {
+					attempt += 1
+					writer.Print("token=xyz\n")
+					if attempt < 2 {
+						F("flake")
+					}
+				}, FlakeAttempts(2))
+
+				It("C", func() {
+					writer.Print("nothing to see here\n")
+				})
+
+				ReportAfterEach(func(report SpecReport) {
+					reportAfterEachOutput = append(reportAfterEachOutput, report.CapturedGinkgoWriterOutput)
+				})
+			})
+		})
+	})
+
+	It("redacts the captured output before it is reported", func() {
+		Ω(reporter.Did.Find("A")).Should(HaveFailed("boom", CapturedGinkgoWriterOutput("logging in with [REDACTED]\n[REDACTED]\n")))
+		Ω(reporter.Did.Find("B")).Should(HavePassed(NumAttempts(2), CapturedGinkgoWriterOutput("[REDACTED]\n[REDACTED]\n")))
+		Ω(reporter.Did.Find("C")).Should(HavePassed(CapturedGinkgoWriterOutput("nothing to see here\n")))
+	})
+
+	It("redacts the output handed to ReportAfterEach", func() {
+		Ω(reportAfterEachOutput).Should(ConsistOf(
+			"logging in with [REDACTED]\n[REDACTED]\n",
+			"[REDACTED]\n[REDACTED]\n",
+			"nothing to see here\n",
+		))
+	})
+
+	It("shifts the timeline so that events line up with the redacted output", func() {
+		report := reporter.Did.Find("A")
+		gw := report.CapturedGinkgoWriterOutput
+
+		byEvent := report.SpecEvents.WithType(types.SpecEventByStart)
+		Ω(byEvent).Should(HaveLen(1))
+		Ω(gw[:byEvent[0].TimelineLocation.Offset]).Should(Equal("logging in with [REDACTED]\n"))
+		Ω(report.Failure.TimelineLocation.Offset).Should(Equal(len(gw)))
+
+		report = reporter.Did.Find("B")
+		Ω(report.AdditionalFailures).Should(HaveLen(1))
+		Ω(report.CapturedGinkgoWriterOutput[:report.AdditionalFailures[0].Failure.TimelineLocation.Offset]).Should(Equal("[REDACTED]\n"))
+	})
+})
@@ -0,0 +1,68 @@
+package internal_integration_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	"github.com/onsi/ginkgo/v2/internal/global"
+	. "github.com/onsi/ginkgo/v2/internal/test_helpers"
+	"github.com/onsi/ginkgo/v2/types"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("reporting why specs were skipped", func() {
+	Context("when specs are filtered out", func() {
+		BeforeEach(func() {
+			conf.FocusStrings = []string{"A|B|C"}
+			success, _ := RunFixture("skip reasons for filters", func() {
+				global.Suite.SetSpecFilter(func(report types.SpecReport) bool {
+					return report.LeafNodeText != "B"
+				})
+				It("A", rt.T("A"))
+				It("B", rt.T("B"))
+				It("C", rt.T("C", func() { Skip("not today") }))
+				It("D", rt.T("D"))
+				PIt("E", rt.T("E"))
+			})
+			Ω(success).Should(BeTrue())
+		})
+
+		It("records the reason on the spec report", func() {
+			Ω(reporter.Did.Find("A").SkipReason).Should(BeEmpty())
+			Ω(reporter.Did.Find("B")).Should(HaveBeenSkipped())
+			Ω(reporter.Did.Find("B").SkipReason).Should(Equal("rejected by the SpecFilter"))
+			Ω(reporter.Did.Find("D")).Should(HaveBeenSkipped())
+			Ω(reporter.Did.Find("D").SkipReason).Should(Equal("does not match --focus"))
+			Ω(reporter.Did.Find("E")).Should(BePending())
+			Ω(reporter.Did.Find("E").SkipReason).Should(BeEmpty())
+		})
+
+		It("leaves the reason empty when the failure message already explains the skip", func() {
+			Ω(reporter.Did.Find("C")).Should(HaveBeenSkippedWithMessage("not today"))
+			Ω(reporter.Did.Find("C").SkipReason).Should(BeEmpty())
+		})
+	})
+
+	Context("when specs are skipped because of programmatic focus", func() {
+		It("records the reason on the spec report", func() {
+			RunFixture("skip reasons for programmatic focus", func() {
+				FIt("A", rt.T("A"))
+				It("B", rt.T("B"))
+			})
+			Ω(reporter.Did.Find("B")).Should(HaveBeenSkipped())
+			Ω(reporter.Did.Find("B").SkipReason).Should(Equal("not programmatically focused"))
+		})
+	})
+
+	Context("when specs are skipped because of --fail-fast", func() {
+		It("records the reason on the spec report", func() {
+			conf.FailFast = true
+			RunFixture("skip reasons for fail-fast", func() {
+				Describe("container", func() {
+					It("A", rt.T("A", func() { F("boom") }))
+					It("B", rt.T("B"))
+				})
+			})
+			Ω(reporter.Did.Find("B")).Should(HaveBeenSkipped())
+			Ω(reporter.Did.Find("B").SkipReason).Should(Equal("an earlier spec failed and --fail-fast is set"))
+		})
+	})
+})
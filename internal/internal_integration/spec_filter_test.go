@@ -0,0 +1,52 @@
+package internal_integration_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	"github.com/onsi/ginkgo/v2/internal/global"
+	. "github.com/onsi/ginkgo/v2/internal/test_helpers"
+	"github.com/onsi/ginkgo/v2/types"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("when a SpecFilter is set", func() {
+	var filterReports []types.SpecReport
+	BeforeEach(func() {
+		conf.SkipStrings = []string{"skipped-by-regex"}
+		filterReports = []types.SpecReport{}
+		success, _ := RunFixture("spec filter", func() {
+			global.Suite.SetSpecFilter(func(report types.SpecReport) bool {
+				filterReports = append(filterReports, report)
+				needsDB, _ := report.MatchesLabelFilter("db")
+				return !needsDB || report.LeafNodeText == "db-but-allowed"
+			})
+			Describe("a container", Label("fast"), func() {
+				It("A", rt.T("A"))
+				It("B", Label("db"), rt.T("B"))
+				It("db-but-allowed", Label("db"), rt.T("db-but-allowed"))
+				It("skipped-by-regex", rt.T("skipped-by-regex"))
+				PIt("pending", rt.T("pending"))
+			})
+		})
+		Ω(success).Should(BeTrue())
+	})
+
+	It("skips the specs the filter rejects", func() {
+		Ω(rt).Should(HaveTracked("A", "db-but-allowed"))
+		Ω(reporter.Did.Find("A")).Should(HavePassed())
+		Ω(reporter.Did.Find("B")).Should(HaveBeenSkipped())
+		Ω(reporter.Did.Find("db-but-allowed")).Should(HavePassed())
+		Ω(reporter.Did.Find("skipped-by-regex")).Should(HaveBeenSkipped())
+		Ω(reporter.Did.Find("pending")).Should(BePending())
+	})
+
+	It("only consults the filter for specs that survive the other filters and counts the filtered specs as skipped", func() {
+		Ω(filterReports).Should(HaveLen(3))
+		Ω(filterReports[0].ContainerHierarchyTexts).Should(Equal([]string{"a container"}))
+		Ω(filterReports[0].ContainerHierarchyLabels).Should(Equal([][]string{{"fast"}}))
+		Ω(filterReports[0].LeafNodeText).Should(Equal("A"))
+		Ω(filterReports[1].LeafNodeLabels).Should(Equal([]string{"db"}))
+
+		Ω(reporter.Begin.PreRunStats.SpecsThatWillRun).Should(Equal(2))
+		Ω(reporter.End).Should(BeASuiteSummary(NSpecs(5), NPassed(2), NPending(1), NSkipped(2)))
+	})
+})
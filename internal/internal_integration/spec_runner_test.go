@@ -0,0 +1,71 @@
+package internal_integration_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	"github.com/onsi/ginkgo/v2/internal/global"
+	. "github.com/onsi/ginkgo/v2/internal/test_helpers"
+	"github.com/onsi/ginkgo/v2/types"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("when a SpecRunner is set", func() {
+	var success bool
+	var runnerReports []types.SpecReport
+	BeforeEach(func() {
+		runnerReports = []types.SpecReport{}
+		flakeCount := 0
+		success, _ = RunFixture("spec runner", func() {
+			global.Suite.SetSpecRunner(func(report types.SpecReport, run func()) {
+				runnerReports = append(runnerReports, report)
+				rt.Run("runner-" + report.LeafNodeText)
+				if report.LeafNodeText == "never-run" {
+					return
+				}
+				run()
+				run()
+			})
+			Describe("a container", func() {
+				BeforeEach(rt.T("bef"))
+				It("A", rt.T("A"))
+				It("B", rt.T("B", func() { F("fail") }))
+				It("flaky", FlakeAttempts(2), rt.T("flaky", func() {
+					flakeCount += 1
+					if flakeCount == 1 {
+						F("flake")
+					}
+				}))
+				It("never-run", rt.T("never-run"))
+				It("skipped", rt.T("skipped", func() { Skip("nope") }))
+				AfterEach(rt.T("aft"))
+			})
+		})
+	})
+
+	It("invokes the runner in place of running each attempt, running the spec only once per attempt", func() {
+		Ω(rt).Should(HaveTracked(
+			"runner-A", "bef", "A", "aft",
+			"runner-B", "bef", "B", "aft",
+			"runner-flaky", "bef", "flaky", "aft",
+			"runner-flaky", "bef", "flaky", "aft",
+			"runner-never-run",
+			"runner-skipped", "bef", "skipped", "aft",
+		))
+	})
+
+	It("passes the runner the current spec report", func() {
+		Ω(runnerReports).Should(HaveLen(6))
+		Ω(runnerReports[0].LeafNodeText).Should(Equal("A"))
+		Ω(runnerReports[2].NumAttempts).Should(Equal(1))
+		Ω(runnerReports[3].NumAttempts).Should(Equal(2))
+	})
+
+	It("still routes failures and populates the report", func() {
+		Ω(success).Should(BeFalse())
+		Ω(reporter.Did.Find("A")).Should(HavePassed())
+		Ω(reporter.Did.Find("B")).Should(HaveFailed("fail"))
+		Ω(reporter.Did.Find("flaky")).Should(HavePassed(NumAttempts(2)))
+		Ω(reporter.Did.Find("never-run")).Should(HaveFailed("The SpecRunner returned without running the spec"))
+		Ω(reporter.Did.Find("skipped")).Should(HaveBeenSkippedWithMessage("nope"))
+		Ω(reporter.End).Should(BeASuiteSummary(false, NSpecs(5), NPassed(2), NFailed(2), NSkipped(1), NFlaked(1)))
+	})
+})
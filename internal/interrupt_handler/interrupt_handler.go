@@ -1,6 +1,7 @@
 package interrupt_handler
 
 import (
+	"context"
 	"os"
 	"os/signal"
 	"sync"
@@ -18,6 +19,7 @@ const (
 	InterruptCauseInvalid InterruptCause = iota
 	InterruptCauseSignal
 	InterruptCauseAbortByOtherProcess
+	InterruptCauseContextDone
 )
 
 type InterruptLevel uint
@@ -35,6 +37,8 @@ func (ic InterruptCause) String() string {
 		return "Interrupted by User"
 	case InterruptCauseAbortByOtherProcess:
 		return "Interrupted by Other Ginkgo Process"
+	case InterruptCauseContextDone:
+		return "Interrupted by Suite Context"
 	}
 	return "INVALID_INTERRUPT_CAUSE"
 }
@@ -139,25 +143,40 @@ func (handler *InterruptHandler) registerForInterrupts() {
 			}
 			abortChannel = nil
 
-			handler.lock.Lock()
-			oldLevel := handler.level
-			handler.cause = interruptCause
-			if handler.level == InterruptLevelUninterrupted {
-				handler.level = InterruptLevelCleanupAndReport
-			} else if handler.level == InterruptLevelCleanupAndReport {
-				handler.level = InterruptLevelReportOnly
-			} else if handler.level == InterruptLevelReportOnly {
-				handler.level = InterruptLevelBailOut
-			}
-			if handler.level != oldLevel {
-				close(handler.c)
-				handler.c = make(chan interface{})
-			}
-			handler.lock.Unlock()
+			handler.interrupt(interruptCause)
 		}
 	}(abortChannel)
 }
 
+// InterruptOnContextDone interrupts the suite when ctx is done (i.e. cancelled or past its deadline).  The interrupt behaves like the first interrupt signal: remaining specs are skipped but cleanup and reporting nodes still run.
+func (handler *InterruptHandler) InterruptOnContextDone(ctx context.Context) {
+	go func() {
+		select {
+		case <-ctx.Done():
+			handler.interrupt(InterruptCauseContextDone)
+		case <-handler.stop:
+		}
+	}()
+}
+
+func (handler *InterruptHandler) interrupt(cause InterruptCause) {
+	handler.lock.Lock()
+	defer handler.lock.Unlock()
+	oldLevel := handler.level
+	handler.cause = cause
+	if handler.level == InterruptLevelUninterrupted {
+		handler.level = InterruptLevelCleanupAndReport
+	} else if handler.level == InterruptLevelCleanupAndReport {
+		handler.level = InterruptLevelReportOnly
+	} else if handler.level == InterruptLevelReportOnly {
+		handler.level = InterruptLevelBailOut
+	}
+	if handler.level != oldLevel {
+		close(handler.c)
+		handler.c = make(chan interface{})
+	}
+}
+
 func (handler *InterruptHandler) Status() InterruptStatus {
 	handler.lock.Lock()
 	status := InterruptStatus{
@@ -4,6 +4,7 @@
 package interrupt_handler_test
 
 import (
+	"context"
 	"syscall"
 	"time"
 
@@ -96,6 +97,42 @@ var _ = Describe("InterruptHandler", func() {
 		})
 	})
 
+	Describe("Interrupting when the suite context is done", func() {
+		var cancel context.CancelFunc
+		BeforeEach(func() {
+			var ctx context.Context
+			ctx, cancel = context.WithCancel(context.Background())
+			DeferCleanup(cancel)
+			interruptHandler = interrupt_handler.NewInterruptHandler(nil, syscall.SIGUSR2)
+			interruptHandler.InterruptOnContextDone(ctx)
+			DeferCleanup(interruptHandler.Stop)
+		})
+
+		It("interrupts once when the context is cancelled, noting the correct cause", func() {
+			status := interruptHandler.Status()
+			Consistently(status.Channel).ShouldNot(BeClosed())
+
+			cancel()
+			Eventually(status.Channel).Should(BeClosed())
+
+			status = interruptHandler.Status()
+			Ω(status.Cause).Should(Equal(interrupt_handler.InterruptCauseContextDone))
+			Ω(status.Level).Should(Equal(interrupt_handler.InterruptLevelCleanupAndReport))
+			Ω(status.Message()).Should(Equal("Interrupted by Suite Context"))
+			Ω(status.ShouldIncludeProgressReport()).Should(BeTrue())
+			Consistently(status.Channel).ShouldNot(BeClosed())
+		})
+
+		It("still escalates when signals arrive after the context is done", func() {
+			cancel()
+			Eventually(interruptHandler.Status).Should(HaveField("Level", interrupt_handler.InterruptLevelCleanupAndReport))
+
+			trigger()
+			Eventually(interruptHandler.Status).Should(HaveField("Level", interrupt_handler.InterruptLevelReportOnly))
+			Ω(interruptHandler.Status().Cause).Should(Equal(interrupt_handler.InterruptCauseSignal))
+		})
+	})
+
 	Describe("Interrupting when another Ginkgo process has aborted", func() {
 		var client parallel_support.Client
 		BeforeEach(func() {
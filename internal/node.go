@@ -61,6 +61,7 @@ type Node struct {
 	NodeTimeout             time.Duration
 	SpecTimeout             time.Duration
 	GracePeriod             time.Duration
+	CostHint                time.Duration
 
 	NodeIDWhereCleanupWasGenerated uint
 }
@@ -92,6 +93,7 @@ type PollProgressAfter time.Duration
 type NodeTimeout time.Duration
 type SpecTimeout time.Duration
 type GracePeriod time.Duration
+type CostHint time.Duration
 
 func (l Labels) MatchesLabelFilter(query string) bool {
 	return types.MustParseLabelFilter(query)(l)
@@ -160,6 +162,8 @@ func isDecoration(arg interface{}) bool {
 		return true
 	case t == reflect.TypeOf(SpecTimeout(0)):
 		return true
+	case t == reflect.TypeOf(CostHint(0)):
+		return true
 	case t == reflect.TypeOf(GracePeriod(0)):
 		return true
 	case t.Kind() == reflect.Slice && isSliceOfDecorations(arg):
@@ -292,6 +296,11 @@ func NewNode(deprecationTracker *types.DeprecationTracker, nodeType types.NodeTy
 			if !nodeType.Is(types.NodeTypeIt) {
 				appendError(types.GinkgoErrors.InvalidDecoratorForNodeType(node.CodeLocation, nodeType, "SpecTimeout"))
 			}
+		case t == reflect.TypeOf(CostHint(0)):
+			node.CostHint = time.Duration(arg.(CostHint))
+			if !nodeType.Is(types.NodeTypeIt) {
+				appendError(types.GinkgoErrors.InvalidDecoratorForNodeType(node.CodeLocation, nodeType, "CostHint"))
+			}
 		case t == reflect.TypeOf(GracePeriod(0)):
 			node.GracePeriod = time.Duration(arg.(GracePeriod))
 			if nodeType.Is(types.NodeTypeContainer) {
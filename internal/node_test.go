@@ -41,6 +41,7 @@ var _ = Describe("Partitioning Decorations", func() {
 			NodeTimeout(time.Second),
 			GracePeriod(time.Second),
 			SpecTimeout(time.Second),
+			CostHint(time.Second),
 			nil,
 			1,
 			[]interface{}{Focus, Pending, []interface{}{Offset(2), Serial, FlakeAttempts(2)}, Ordered, Label("a", "b", "c"), NodeTimeout(time.Second)},
@@ -69,6 +70,7 @@ var _ = Describe("Partitioning Decorations", func() {
 			NodeTimeout(time.Second),
 			GracePeriod(time.Second),
 			SpecTimeout(time.Second),
+			CostHint(time.Second),
 			[]interface{}{Focus, Pending, []interface{}{Offset(2), Serial, FlakeAttempts(2)}, Ordered, Label("a", "b", "c"), NodeTimeout(time.Second)},
 			PollProgressInterval(time.Second),
 			PollProgressAfter(time.Second),
@@ -451,6 +453,21 @@ var _ = Describe("Constructing nodes", func() {
 		})
 	})
 
+	Describe("the CostHint decorator", func() {
+		It("records the cost hint", func() {
+			node, errors := internal.NewNode(dt, ntIt, "spec", body, cl, CostHint(time.Minute))
+			Ω(errors).Should(BeEmpty())
+			Ω(node.CostHint).Should(Equal(time.Minute))
+		})
+
+		It("only allows CostHint to be applied to Its", func() {
+			node, errors := internal.NewNode(dt, ntCon, "container", body, cl, CostHint(time.Minute))
+			Ω(node).Should(BeZero())
+			Ω(errors).Should(ConsistOf(types.GinkgoErrors.InvalidDecoratorForNodeType(cl, ntCon, "CostHint")))
+			Ω(dt.DidTrackDeprecations()).Should(BeFalse())
+		})
+	})
+
 	Describe("the timeout-related decorators", func() {
 		It("correctly assigned timeouts when specified", func() {
 			node, errors := internal.NewNode(dt, ntIt, "spec", func(_ SpecContext) {}, cl, NodeTimeout(time.Second), SpecTimeout(2*time.Second), GracePeriod(3*time.Second))
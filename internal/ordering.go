@@ -3,7 +3,9 @@ package internal
 import (
 	"math/rand"
 	"sort"
+	"time"
 
+	"github.com/onsi/ginkgo/v2/reporters"
 	"github.com/onsi/ginkgo/v2/types"
 )
 
@@ -79,8 +81,12 @@ func OrderSpecs(specs Specs, suiteConfig types.SuiteConfig) (GroupedSpecIndices,
 
 		Developers can set -randomizeAllSpecs to shuffle _all_ specs.
 
+		Alternatively, developers can set -order-by-location to turn off shuffling altogether and run specs sorted by file name and line number.  -randomizeAllSpecs wins if both are set.
+
 		In addition, spec containers can be marked as Ordered.  Specs within an Ordered container are never shuffled.
 
+		When running in parallel the heaviest groups of specs are handed out first.  Weights come from -timing-profile-from (a JSON report from a previous run), if set, and then from CostHint decorators.
+
 		Finally, specs and spec containers can be marked as Serial.  When running in parallel, serial specs run on Process #1 _after_ all other processes have finished.
 	*/
 
@@ -141,6 +147,12 @@ func OrderSpecs(specs Specs, suiteConfig types.SuiteConfig) (GroupedSpecIndices,
 	// now we permute the sorted shufflable grouping IDs and build the ordered Groups
 	orderedGroups := GroupedSpecIndices{}
 	permutation := r.Perm(len(shufflableGroupingIDs))
+	if suiteConfig.OrderByLocation && !suiteConfig.RandomizeAllSpecs {
+		// the grouping IDs are already sorted by location so we simply preserve their order
+		for j := range permutation {
+			permutation[j] = j
+		}
+	}
 	for _, j := range permutation {
 		//let's get the execution group IDs for this shufflable group:
 		executionGroupIDsForJ := shufflableGroupingIDToGroupIDs[shufflableGroupingIDs[j]]
@@ -150,6 +162,13 @@ func OrderSpecs(specs Specs, suiteConfig types.SuiteConfig) (GroupedSpecIndices,
 		}
 	}
 
+	// if the suite is split into phases, we run the groups phase by phase - preserving the shuffled order within each phase
+	if len(suiteConfig.PhaseLabels) > 0 {
+		sort.SliceStable(orderedGroups, func(i, j int) bool {
+			return PhaseIndexForGroup(specs, orderedGroups[i], suiteConfig.PhaseLabels) < PhaseIndexForGroup(specs, orderedGroups[j], suiteConfig.PhaseLabels)
+		})
+	}
+
 	// If we're running in series, we're done.
 	if suiteConfig.ParallelTotal == 1 {
 		return orderedGroups, GroupedSpecIndices{}
@@ -167,5 +186,72 @@ func OrderSpecs(specs Specs, suiteConfig types.SuiteConfig) (GroupedSpecIndices,
 		}
 	}
 
+	// hand out the heaviest groups first so they are balanced across the parallel processes
+	weight := specWeight
+	if suiteConfig.TimingProfileFrom != "" {
+		// if the timing profile is missing or empty we fall back to the CostHints.  the CLI warns about this.
+		if profile, _ := reporters.LoadTimingProfile(suiteConfig.TimingProfileFrom); len(profile) > 0 {
+			weight = specWeightFromTimingProfile(profile)
+		}
+	}
+	parallelizableGroups = SortGroupedSpecIndicesByWeight(specs, parallelizableGroups, weight)
+
 	return parallelizableGroups, serialGroups
 }
+
+// specWeight estimates how long a spec will take to run.  Specs without a CostHint decorator have no weight.
+func specWeight(spec Spec) time.Duration {
+	return spec.CostHint()
+}
+
+// specWeightFromTimingProfile prefers how long the spec took last time, then its CostHint, and otherwise assumes the spec takes the average time
+func specWeightFromTimingProfile(profile reporters.TimingProfile) func(Spec) time.Duration {
+	average := profile.Average()
+	return func(spec Spec) time.Duration {
+		if duration, ok := profile[spec.Text()]; ok {
+			return duration
+		}
+		if costHint := spec.CostHint(); costHint > 0 {
+			return costHint
+		}
+		return average
+	}
+}
+
+// PhaseIndexForGroup returns the latest phase that any of the specs in the group belong to.  Specs in a group (e.g. an Ordered container) must run together so the group waits for its latest phase.
+func PhaseIndexForGroup(specs Specs, specIndices SpecIndices, phaseLabels []string) int {
+	phaseIdx := 0
+	for _, idx := range specIndices {
+		phaseIdx = max(phaseIdx, specs[idx].PhaseIndex(phaseLabels))
+	}
+	return phaseIdx
+}
+
+/*
+SortGroupedSpecIndicesByWeight returns a copy of groupedSpecIndices reordered so that the heaviest groups come first.  A group's weight is the sum of the weights of its specs, as returned by weight.
+
+When running in parallel each process pulls the next group off a shared counter as soon as it becomes idle.  Handing out the heaviest groups first therefore implements the greedy longest-processing-time-first heuristic: each group lands on whichever process frees up first - i.e. the least loaded one.  This balances processes far better than handing out groups in an arbitrary order when spec durations are skewed.
+
+The sort is stable so groups with equal weight (including specs with no known weight) retain their relative order and the result remains deterministic for a given seed.
+*/
+func SortGroupedSpecIndicesByWeight(specs Specs, groupedSpecIndices GroupedSpecIndices, weight func(Spec) time.Duration) GroupedSpecIndices {
+	weights := make([]time.Duration, len(groupedSpecIndices))
+	for i, specIndices := range groupedSpecIndices {
+		for _, idx := range specIndices {
+			weights[i] += weight(specs[idx])
+		}
+	}
+
+	indexes := make([]int, len(groupedSpecIndices))
+	for i := range indexes {
+		indexes[i] = i
+	}
+	sort.SliceStable(indexes, func(i, j int) bool {
+		return weights[indexes[i]] > weights[indexes[j]]
+	})
+	sorted := make(GroupedSpecIndices, len(groupedSpecIndices))
+	for i, idx := range indexes {
+		sorted[i] = groupedSpecIndices[idx]
+	}
+	return sorted
+}
@@ -1,6 +1,7 @@
 package internal_test
 
 import (
+	"path/filepath"
 	"strings"
 	"time"
 
@@ -8,6 +9,7 @@ import (
 	. "github.com/onsi/gomega"
 
 	"github.com/onsi/ginkgo/v2/internal"
+	"github.com/onsi/ginkgo/v2/reporters"
 	"github.com/onsi/ginkgo/v2/types"
 )
 
@@ -155,6 +157,38 @@ var _ = Describe("OrderSpecs", func() {
 				}
 			}
 		})
+
+		Context("when configured to order by location", func() {
+			BeforeEach(func() {
+				conf.OrderByLocation = true
+			})
+
+			It("runs the specs sorted by file name and line number, regardless of seed or load order", func() {
+				for conf.RandomSeed = 1; conf.RandomSeed < 10; conf.RandomSeed += 1 {
+					specsOrderBA := Specs{}
+					specsOrderBA = append(specsOrderBA, specsInFileB...)
+					specsOrderBA = append(specsOrderBA, specsInFileA...)
+
+					groupedSpecIndices, serialSpecIndices := internal.OrderSpecs(specsOrderBA, conf)
+					Ω(serialSpecIndices).Should(BeEmpty())
+					Ω(getTexts(specsOrderBA, groupedSpecIndices).Join()).Should(Equal("ABCDEFGH"))
+				}
+			})
+
+			It("lets randomize-all take precedence", func() {
+				conf.RandomizeAllSpecs = true
+				specsOrderAB := Specs{}
+				specsOrderAB = append(specsOrderAB, specsInFileA...)
+				specsOrderAB = append(specsOrderAB, specsInFileB...)
+
+				orders := map[string]bool{}
+				for conf.RandomSeed = 1; conf.RandomSeed < 10; conf.RandomSeed += 1 {
+					groupedSpecIndices, _ := internal.OrderSpecs(specsOrderAB, conf)
+					orders[getTexts(specsOrderAB, groupedSpecIndices).Join()] = true
+				}
+				Ω(len(orders)).Should(BeNumerically(">", 1))
+			})
+		})
 	})
 
 	Context("when there are ordered specs and randomize-all is true", func() {
@@ -276,6 +310,74 @@ var _ = Describe("OrderSpecs", func() {
 			})
 		})
 
+		Context("and some specs have a CostHint", func() {
+			BeforeEach(func() {
+				con1 := N(ntCon, Ordered)
+				specs = Specs{
+					S(N("A", ntIt)),
+					S(N("B", ntIt, CostHint(time.Minute))),
+					S(con1, N("C", ntIt, CostHint(time.Second))),
+					S(con1, N("D", ntIt, CostHint(2*time.Minute))),
+					S(N("E", ntIt)),
+					S(N("F", ntIt, CostHint(3*time.Minute))),
+					S(N("G", ntIt, Serial, CostHint(time.Hour))),
+				}
+			})
+
+			It("hands out the heaviest parallelizable groups first when running in parallel", func() {
+				conf.ParallelTotal = 2
+				for conf.RandomSeed = 1; conf.RandomSeed < 10; conf.RandomSeed += 1 {
+					groupedSpecIndices, serialSpecIndices := internal.OrderSpecs(specs, conf)
+					Ω(getTexts(specs, groupedSpecIndices)[:4]).Should(Equal(SpecTexts{"F", "C", "D", "B"}))
+					Ω(getTexts(specs, groupedSpecIndices)[4:]).Should(ConsistOf("A", "E"))
+					Ω(getTexts(specs, serialSpecIndices)).Should(Equal(SpecTexts{"G"}))
+				}
+			})
+
+			It("ignores the CostHints when running in series", func() {
+				conf.ParallelTotal = 1
+				orders := map[string]bool{}
+				for conf.RandomSeed = 1; conf.RandomSeed < 10; conf.RandomSeed += 1 {
+					groupedSpecIndices, _ := internal.OrderSpecs(specs, conf)
+					orders[getTexts(specs, groupedSpecIndices).Join()] = true
+				}
+				Ω(len(orders)).Should(BeNumerically(">", 1))
+			})
+
+			Context("and a timing profile from a previous run is provided", func() {
+				BeforeEach(func() {
+					timing := func(text string, runTime time.Duration) types.SpecReport {
+						return types.SpecReport{LeafNodeType: types.NodeTypeIt, LeafNodeText: text, State: types.SpecStatePassed, RunTime: runTime}
+					}
+					conf.TimingProfileFrom = filepath.Join(GinkgoT().TempDir(), "report.json")
+					Ω(reporters.GenerateJSONReport(types.Report{SpecReports: types.SpecReports{
+						timing("A", 5*time.Minute),
+						timing("C", time.Minute),
+						timing("D", time.Minute),
+						timing("F", 10*time.Second),
+						{LeafNodeType: types.NodeTypeIt, LeafNodeText: "E", State: types.SpecStateSkipped},
+					}}, conf.TimingProfileFrom)).Should(Succeed())
+				})
+
+				It("prefers the recorded timings, then the CostHints, and otherwise assumes the average", func() {
+					conf.ParallelTotal = 2
+					for conf.RandomSeed = 1; conf.RandomSeed < 10; conf.RandomSeed += 1 {
+						groupedSpecIndices, serialSpecIndices := internal.OrderSpecs(specs, conf)
+						// A: 5m, C+D: 2m, E: 1m47.5s (the average), B: 1m (its CostHint), F: 10s
+						Ω(getTexts(specs, groupedSpecIndices)).Should(Equal(SpecTexts{"A", "C", "D", "E", "B", "F"}))
+						Ω(getTexts(specs, serialSpecIndices)).Should(Equal(SpecTexts{"G"}))
+					}
+				})
+
+				It("falls back to the CostHints if the timing profile can't be loaded", func() {
+					conf.ParallelTotal = 2
+					conf.TimingProfileFrom = filepath.Join(GinkgoT().TempDir(), "missing.json")
+					groupedSpecIndices, _ := internal.OrderSpecs(specs, conf)
+					Ω(getTexts(specs, groupedSpecIndices)[:4]).Should(Equal(SpecTexts{"F", "C", "D", "B"}))
+				})
+			})
+		})
+
 		Describe("presorting-specs", func() {
 			BeforeEach(func() {
 				conA0 := N(ntCon, CL("file-A", 1))
@@ -363,3 +465,50 @@ var _ = Describe("OrderSpecs", func() {
 		})
 	})
 })
+
+var _ = Describe("SortGroupedSpecIndicesByWeight", func() {
+	var specs Specs
+	var weights map[string]time.Duration
+	var weight func(Spec) time.Duration
+
+	BeforeEach(func() {
+		con1 := N(ntCon, Ordered)
+		specs = Specs{
+			S(N("A", ntIt)),
+			S(N("B", ntIt)),
+			S(con1, N("C", ntIt)),
+			S(con1, N("D", ntIt)),
+			S(N("E", ntIt)),
+			S(N("F", ntIt)),
+		}
+		weights = map[string]time.Duration{
+			"A": time.Second,
+			"B": 10 * time.Second,
+			"C": 4 * time.Second,
+			"D": 4 * time.Second,
+			"F": time.Second,
+		}
+		weight = func(spec Spec) time.Duration {
+			return weights[spec.Text()]
+		}
+	})
+
+	It("orders groups by descending total weight, treating an ordered container's specs as a single group", func() {
+		groupedSpecIndices := internal.GroupedSpecIndices{{0}, {1}, {2, 3}, {4}, {5}}
+		sorted := internal.SortGroupedSpecIndicesByWeight(specs, groupedSpecIndices, weight)
+		Ω(sorted).Should(Equal(internal.GroupedSpecIndices{{1}, {2, 3}, {0}, {5}, {4}}))
+		Ω(getTexts(specs, sorted)).Should(Equal(SpecTexts{"B", "C", "D", "A", "F", "E"}))
+	})
+
+	It("preserves the original order of groups with equal weights", func() {
+		groupedSpecIndices := internal.GroupedSpecIndices{{5}, {4}, {0}, {2, 3}, {1}}
+		sorted := internal.SortGroupedSpecIndicesByWeight(specs, groupedSpecIndices, weight)
+		Ω(getTexts(specs, sorted)).Should(Equal(SpecTexts{"B", "C", "D", "F", "A", "E"}))
+	})
+
+	It("does not modify the passed-in groups", func() {
+		groupedSpecIndices := internal.GroupedSpecIndices{{0}, {1}, {2, 3}, {4}, {5}}
+		internal.SortGroupedSpecIndicesByWeight(specs, groupedSpecIndices, weight)
+		Ω(groupedSpecIndices).Should(Equal(internal.GroupedSpecIndices{{0}, {1}, {2, 3}, {4}, {5}}))
+	})
+})
@@ -0,0 +1,149 @@
+package internal
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/onsi/ginkgo/v2/types"
+)
+
+const REDACTED_OUTPUT_PLACEHOLDER = "[REDACTED]"
+
+/*
+OutputRedactor replaces any output that matches one of its regular expressions with REDACTED_OUTPUT_PLACEHOLDER.
+
+Captured GinkgoWriter output is indexed by the TimelineLocation offsets of the events in a spec's timeline.  Since redaction can change the length of the output, RedactSpecReport shifts those offsets so that the timeline can still be reconstructed from the redacted output.
+*/
+type OutputRedactor struct {
+	regexps []*regexp.Regexp
+}
+
+func NewOutputRedactor(patterns []string) OutputRedactor {
+	redactor := OutputRedactor{}
+	for _, pattern := range patterns {
+		redactor.regexps = append(redactor.regexps, regexp.MustCompile(pattern))
+	}
+	return redactor
+}
+
+func (r OutputRedactor) IsZero() bool {
+	return len(r.regexps) == 0
+}
+
+func (r OutputRedactor) Redact(s string) string {
+	redacted, _ := r.redact(s)
+	return redacted
+}
+
+// redact returns the redacted string along with a function that maps offsets in s to offsets in the redacted string
+func (r OutputRedactor) redact(s string) (string, func(int) int) {
+	matches := [][]int{}
+	for _, re := range r.regexps {
+		for _, match := range re.FindAllStringIndex(s, -1) {
+			if match[1] > match[0] {
+				matches = append(matches, match)
+			}
+		}
+	}
+	if len(matches) == 0 {
+		return s, func(offset int) int { return offset }
+	}
+
+	// merge overlapping matches so that each run of sensitive output is replaced by a single placeholder
+	sort.Slice(matches, func(i, j int) bool { return matches[i][0] < matches[j][0] })
+	merged := [][]int{matches[0]}
+	for _, match := range matches[1:] {
+		last := merged[len(merged)-1]
+		if match[0] <= last[1] {
+			if match[1] > last[1] {
+				last[1] = match[1]
+			}
+		} else {
+			merged = append(merged, match)
+		}
+	}
+
+	out := &strings.Builder{}
+	cursor := 0
+	for _, match := range merged {
+		out.WriteString(s[cursor:match[0]])
+		out.WriteString(REDACTED_OUTPUT_PLACEHOLDER)
+		cursor = match[1]
+	}
+	out.WriteString(s[cursor:])
+
+	remap := func(offset int) int {
+		delta := 0
+		for _, match := range merged {
+			if offset <= match[0] {
+				break
+			}
+			if offset < match[1] {
+				// events that occurred in the middle of redacted output are placed after the placeholder
+				return match[0] + delta + len(REDACTED_OUTPUT_PLACEHOLDER)
+			}
+			delta += len(REDACTED_OUTPUT_PLACEHOLDER) - (match[1] - match[0])
+		}
+		return offset + delta
+	}
+
+	return out.String(), remap
+}
+
+// RedactSpecReport redacts the output captured in the passed-in report and shifts the offsets of the report's timeline to match
+//
+// The progress reports stored on a SpecReport do not include any captured GinkgoWriter output so only their offsets are updated.  Use RedactProgressReport to redact progress reports as they are emitted.
+func (r OutputRedactor) RedactSpecReport(report types.SpecReport) types.SpecReport {
+	if r.IsZero() {
+		return report
+	}
+	report.CapturedStdOutErr = r.Redact(report.CapturedStdOutErr)
+
+	var remap func(int) int
+	report.CapturedGinkgoWriterOutput, remap = r.redact(report.CapturedGinkgoWriterOutput)
+
+	report.Failure.TimelineLocation.Offset = remap(report.Failure.TimelineLocation.Offset)
+	if len(report.AdditionalFailures) > 0 {
+		additionalFailures := make([]types.AdditionalFailure, len(report.AdditionalFailures))
+		for i, af := range report.AdditionalFailures {
+			af.Failure.TimelineLocation.Offset = remap(af.Failure.TimelineLocation.Offset)
+			additionalFailures[i] = af
+		}
+		report.AdditionalFailures = additionalFailures
+	}
+	if len(report.ReportEntries) > 0 {
+		reportEntries := make([]types.ReportEntry, len(report.ReportEntries))
+		for i, entry := range report.ReportEntries {
+			entry.TimelineLocation.Offset = remap(entry.TimelineLocation.Offset)
+			reportEntries[i] = entry
+		}
+		report.ReportEntries = reportEntries
+	}
+	if len(report.ProgressReports) > 0 {
+		progressReports := make([]types.ProgressReport, len(report.ProgressReports))
+		for i, pr := range report.ProgressReports {
+			pr.TimelineLocation.Offset = remap(pr.TimelineLocation.Offset)
+			progressReports[i] = pr
+		}
+		report.ProgressReports = progressReports
+	}
+	if len(report.SpecEvents) > 0 {
+		specEvents := make([]types.SpecEvent, len(report.SpecEvents))
+		for i, event := range report.SpecEvents {
+			event.TimelineLocation.Offset = remap(event.TimelineLocation.Offset)
+			specEvents[i] = event
+		}
+		report.SpecEvents = specEvents
+	}
+	return report
+}
+
+// RedactProgressReport redacts the GinkgoWriter output captured in the passed-in progress report
+func (r OutputRedactor) RedactProgressReport(report types.ProgressReport) types.ProgressReport {
+	if r.IsZero() {
+		return report
+	}
+	report.CapturedGinkgoWriterOutput = r.Redact(report.CapturedGinkgoWriterOutput)
+	return report
+}
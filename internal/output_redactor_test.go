@@ -0,0 +1,42 @@
+package internal_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/onsi/ginkgo/v2/internal"
+	"github.com/onsi/ginkgo/v2/types"
+)
+
+var _ = Describe("OutputRedactor", func() {
+	It("does nothing when there are no patterns", func() {
+		redactor := internal.NewOutputRedactor(nil)
+		Ω(redactor.IsZero()).Should(BeTrue())
+		Ω(redactor.Redact("secret")).Should(Equal("secret"))
+	})
+
+	It("replaces every match with the placeholder, merging overlapping matches", func() {
+		redactor := internal.NewOutputRedactor([]string{`abc\d`, `c\d+`, `x*`})
+		Ω(redactor.Redact("abc123 - c9 - abc")).Should(Equal("[REDACTED] - [REDACTED] - abc"))
+	})
+
+	It("shifts timeline offsets to match the redacted output", func() {
+		redactor := internal.NewOutputRedactor([]string{`secret`})
+		report := redactor.RedactSpecReport(types.SpecReport{
+			CapturedGinkgoWriterOutput: "a secret\nsecret\nb\n",
+			CapturedStdOutErr:          "stdout secret",
+			Failure:                    types.Failure{TimelineLocation: types.TimelineLocation{Offset: 18}},
+			ReportEntries:              types.ReportEntries{{TimelineLocation: types.TimelineLocation{Offset: 2}}},
+			SpecEvents:                 types.SpecEvents{{TimelineLocation: types.TimelineLocation{Offset: 12}}},
+			ProgressReports:            []types.ProgressReport{{TimelineLocation: types.TimelineLocation{Offset: 16}}},
+		})
+
+		Ω(report.CapturedGinkgoWriterOutput).Should(Equal("a [REDACTED]\n[REDACTED]\nb\n"))
+		Ω(report.CapturedStdOutErr).Should(Equal("stdout [REDACTED]"))
+		Ω(report.Failure.TimelineLocation.Offset).Should(Equal(26))
+		Ω(report.ReportEntries[0].TimelineLocation.Offset).Should(Equal(2))
+		// offsets that fall within redacted output are moved to the end of the placeholder
+		Ω(report.SpecEvents[0].TimelineLocation.Offset).Should(Equal(23))
+		Ω(report.ProgressReports[0].TimelineLocation.Offset).Should(Equal(24))
+	})
+})
@@ -215,6 +215,24 @@ var _ = Describe("The Parallel Support Client & Server", func() {
 				})
 			})
 
+			Describe("progress summaries", func() {
+				BeforeEach(func() {
+					beginReport := types.Report{StartTime: time.Now(), SuiteConfig: types.SuiteConfig{ProgressSummaryInterval: 10 * time.Millisecond}}
+					for i := 0; i < 3; i++ {
+						Ω(client.PostSuiteWillBegin(beginReport)).Should(Succeed())
+					}
+				})
+
+				It("emits summaries on an interval, even when no specs complete, until the suite ends", func() {
+					Eventually(reporter.NumProgressSummaries).Should(BeNumerically(">=", 2))
+					for i := 0; i < 3; i++ {
+						Ω(client.PostSuiteDidEnd(types.Report{})).Should(Succeed())
+					}
+					n := reporter.NumProgressSummaries()
+					Consistently(reporter.NumProgressSummaries, 50*time.Millisecond).Should(Equal(n))
+				})
+			})
+
 			Describe("Synchronization endpoints", func() {
 				var proc1Exited, proc2Exited, proc3Exited chan interface{}
 				BeforeEach(func() {
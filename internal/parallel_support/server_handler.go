@@ -4,6 +4,7 @@ import (
 	"io"
 	"os"
 	"sync"
+	"time"
 
 	"github.com/onsi/ginkgo/v2/reporters"
 	"github.com/onsi/ginkgo/v2/types"
@@ -34,6 +35,10 @@ type ServerHandler struct {
 	numSuiteDidEnds   int
 	aggregatedReport  types.Report
 	reportHoldingArea []types.SpecReport
+
+	progressSummaryReport types.Report
+	lastProgressSummary   time.Time
+	stopProgressSummaries chan interface{}
 }
 
 func newServerHandler(parallelTotal int, reporter reporters.Reporter) *ServerHandler {
@@ -59,11 +64,16 @@ func (handler *ServerHandler) SpecSuiteWillBegin(report types.Report, _ *Void) e
 	// all summaries are identical, so it's fine to simply emit the last one of these
 	if handler.numSuiteDidBegins == handler.parallelTotal {
 		handler.reporter.SuiteWillBegin(report)
+		handler.progressSummaryReport = report
+		handler.lastProgressSummary = report.StartTime
 
 		for _, summary := range handler.reportHoldingArea {
 			handler.reporter.WillRun(summary)
 			handler.reporter.DidRun(summary)
+			handler.progressSummaryReport.SpecReports = append(handler.progressSummaryReport.SpecReports, summary)
 		}
+		handler.emitProgressSummaryIfNeedBe()
+		handler.startProgressSummaries()
 
 		handler.reportHoldingArea = nil
 	}
@@ -78,6 +88,8 @@ func (handler *ServerHandler) DidRun(report types.SpecReport, _ *Void) error {
 	if handler.numSuiteDidBegins == handler.parallelTotal {
 		handler.reporter.WillRun(report)
 		handler.reporter.DidRun(report)
+		handler.progressSummaryReport.SpecReports = append(handler.progressSummaryReport.SpecReports, report)
+		handler.emitProgressSummaryIfNeedBe()
 	} else {
 		handler.reportHoldingArea = append(handler.reportHoldingArea, report)
 	}
@@ -97,6 +109,9 @@ func (handler *ServerHandler) SpecSuiteDidEnd(report types.Report, _ *Void) erro
 	}
 
 	if handler.numSuiteDidEnds == handler.parallelTotal {
+		if handler.stopProgressSummaries != nil {
+			close(handler.stopProgressSummaries)
+		}
 		handler.reporter.SuiteDidEnd(handler.aggregatedReport)
 		close(handler.done)
 	}
@@ -104,6 +119,55 @@ func (handler *ServerHandler) SpecSuiteDidEnd(report types.Report, _ *Void) erro
 	return nil
 }
 
+// startProgressSummaries emits a progress summary every ProgressSummaryInterval so that a long-running (or hung) spec on any process doesn't silence the summaries.  handler.lock must be held.
+func (handler *ServerHandler) startProgressSummaries() {
+	interval := handler.progressSummaryReport.SuiteConfig.ProgressSummaryInterval
+	if interval <= 0 {
+		return
+	}
+	if _, ok := handler.reporter.(reporters.ProgressSummaryReporter); !ok {
+		return
+	}
+	ticker := time.NewTicker(interval)
+	stop := make(chan interface{})
+	handler.stopProgressSummaries = stop
+	go func() {
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				handler.lock.Lock()
+				select {
+				case <-stop:
+				default:
+					handler.emitProgressSummaryIfNeedBe()
+				}
+				handler.lock.Unlock()
+			case <-stop:
+				return
+			}
+		}
+	}()
+}
+
+func (handler *ServerHandler) emitProgressSummaryIfNeedBe() {
+	if handler.progressSummaryReport.SuiteConfig.ProgressSummaryInterval <= 0 {
+		return
+	}
+	progressSummaryReporter, ok := handler.reporter.(reporters.ProgressSummaryReporter)
+	if !ok {
+		return
+	}
+	now := time.Now()
+	if now.Sub(handler.lastProgressSummary) < handler.progressSummaryReport.SuiteConfig.ProgressSummaryInterval {
+		return
+	}
+	handler.lastProgressSummary = now
+	report := handler.progressSummaryReport
+	report.RunTime = now.Sub(report.StartTime)
+	progressSummaryReporter.EmitProgressSummary(report)
+}
+
 func (handler *ServerHandler) EmitOutput(output []byte, n *int) error {
 	var err error
 	*n, err = handler.outputDestination.Write(output)
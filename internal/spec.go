@@ -10,6 +10,8 @@ import (
 type Spec struct {
 	Nodes Nodes
 	Skip  bool
+	// SkipReason explains why Skip was set, e.g. "does not match --focus"
+	SkipReason string
 }
 
 func (s Spec) SubjectID() uint {
@@ -30,6 +32,10 @@ func (s Spec) FirstNodeWithType(nodeTypes types.NodeType) Node {
 	return s.Nodes.FirstNodeWithType(nodeTypes)
 }
 
+func (s Spec) PhaseIndex(phaseLabels []string) int {
+	return types.PhaseIndexForLabels(s.Nodes.UnionOfLabels(), phaseLabels)
+}
+
 func (s Spec) FlakeAttempts() int {
 	flakeAttempts := 0
 	for i := range s.Nodes {
@@ -56,6 +62,10 @@ func (s Spec) SpecTimeout() time.Duration {
 	return s.FirstNodeWithType(types.NodeTypeIt).SpecTimeout
 }
 
+func (s Spec) CostHint() time.Duration {
+	return s.FirstNodeWithType(types.NodeTypeIt).CostHint
+}
+
 type Specs []Spec
 
 func (s Specs) HasAnySpecsMarkedPending() bool {
@@ -2,6 +2,7 @@ package internal
 
 import (
 	"fmt"
+	"strings"
 	"sync"
 	"time"
 
@@ -22,6 +23,20 @@ const (
 
 var PROGRESS_REPORTER_DEADLING = 5 * time.Second
 
+/*
+SpecRunner is an optional hook that, when set, is invoked in place of Ginkgo running each spec attempt directly.
+
+It is passed the current spec report and a run function.  The SpecRunner must call run synchronously (i.e. before returning) - run executes the spec's nodes and records failures, timings, and output on the spec report just as Ginkgo would without a SpecRunner.  If the SpecRunner returns without calling run the spec is marked as failed.
+*/
+type SpecRunner func(report types.SpecReport, run func())
+
+/*
+SpecFilter is an optional predicate that, when set, is called once for each spec that survives Ginkgo's focus and skip filters.  Specs for which it returns false are skipped.
+
+It is passed the spec's initial report - the report's container and leaf node texts, locations, and labels are populated but the spec has not run yet.
+*/
+type SpecFilter func(report types.SpecReport) bool
+
 type Suite struct {
 	tree               *TreeNode
 	topLevelContainers Nodes
@@ -42,6 +57,10 @@ type Suite struct {
 	deadline          time.Time
 
 	skipAll              bool
+	skipAllReason        string
+	skipLaterPhases      bool
+	currentPhaseLabel    string
+	containerFailures    map[uint]int
 	report               types.Report
 	currentSpecReport    types.SpecReport
 	currentNode          Node
@@ -52,6 +71,10 @@ type Suite struct {
 	currentByStep types.SpecEvent
 	timelineOrder int
 
+	progressSummaryLock   *sync.Mutex
+	progressSummaryReport types.Report
+	lastProgressSummary   time.Time
+
 	/*
 		We don't need to lock around all operations.  Just those that *could* happen concurrently.
 
@@ -65,6 +88,11 @@ type Suite struct {
 	selectiveLock *sync.Mutex
 
 	client parallel_support.Client
+
+	specRunner SpecRunner
+	specFilter SpecFilter
+
+	outputRedactor OutputRedactor
 }
 
 func NewSuite() *Suite {
@@ -73,7 +101,8 @@ func NewSuite() *Suite {
 		phase:                   PhaseBuildTopLevel,
 		ProgressReporterManager: NewProgressReporterManager(),
 
-		selectiveLock: &sync.Mutex{},
+		selectiveLock:       &sync.Mutex{},
+		progressSummaryLock: &sync.Mutex{},
 	}
 }
 
@@ -88,6 +117,7 @@ func (suite *Suite) Clone() (*Suite, error) {
 		topLevelContainers:      suite.topLevelContainers.Clone(),
 		suiteNodes:              suite.suiteNodes.Clone(),
 		selectiveLock:           &sync.Mutex{},
+		progressSummaryLock:     &sync.Mutex{},
 	}, nil
 }
 
@@ -120,6 +150,12 @@ func (suite *Suite) Run(description string, suiteLabels Labels, suitePath string
 	suite.outputInterceptor = outputInterceptor
 	suite.interruptHandler = interruptHandler
 	suite.config = suiteConfig
+	suite.outputRedactor = NewOutputRedactor(suite.config.RedactOutputPatterns)
+	suite.containerFailures = map[uint]int{}
+
+	if suite.specFilter != nil {
+		specs = suite.applySpecFilter(specs)
+	}
 
 	if suite.config.Timeout > 0 {
 		suite.deadline = time.Now().Add(suite.config.Timeout)
@@ -134,6 +170,28 @@ func (suite *Suite) Run(description string, suiteLabels Labels, suitePath string
 	return success, hasProgrammaticFocus
 }
 
+func (suite *Suite) SetSpecRunner(specRunner SpecRunner) {
+	suite.specRunner = specRunner
+}
+
+func (suite *Suite) SetSpecFilter(specFilter SpecFilter) {
+	suite.specFilter = specFilter
+}
+
+// applySpecFilter runs before the specs are ordered so that the parallel split is computed over the filtered specs
+func (suite *Suite) applySpecFilter(specs Specs) Specs {
+	g := newGroup(suite)
+	for i := range specs {
+		if specs[i].Skip {
+			continue
+		}
+		if !suite.specFilter(g.initialReportForSpec(specs[i])) {
+			specs[i].Skip, specs[i].SkipReason = true, "rejected by the SpecFilter"
+		}
+	}
+	return specs
+}
+
 func (suite *Suite) InRunPhase() bool {
 	return suite.phase == PhaseRun
 }
@@ -383,7 +441,7 @@ func (suite *Suite) generateProgressReport(fullReport bool) types.ProgressReport
 	if err != nil {
 		fmt.Printf("{{red}}Failed to generate progress report:{{/}}\n%s\n", err.Error())
 	}
-	return pr
+	return suite.outputRedactor.RedactProgressReport(pr)
 }
 
 func (suite *Suite) handleProgressSignal() {
@@ -411,6 +469,7 @@ func (suite *Suite) isRunningInParallel() bool {
 }
 
 func (suite *Suite) processCurrentSpecReport() {
+	suite.currentSpecReport = suite.outputRedactor.RedactSpecReport(suite.currentSpecReport)
 	suite.reporter.DidRun(suite.currentSpecReport)
 	if suite.isRunningInParallel() {
 		suite.client.PostDidRun(suite.currentSpecReport)
@@ -421,11 +480,75 @@ func (suite *Suite) processCurrentSpecReport() {
 		suite.report.SuiteSucceeded = false
 		if suite.config.FailFast || suite.currentSpecReport.State.Is(types.SpecStateAborted) {
 			suite.skipAll = true
+			if suite.currentSpecReport.State.Is(types.SpecStateAborted) {
+				suite.skipAllReason = "an earlier spec aborted the suite"
+			} else {
+				suite.skipAllReason = "an earlier spec failed and --fail-fast is set"
+			}
 			if suite.isRunningInParallel() {
 				suite.client.PostAbort()
 			}
 		}
 	}
+
+	suite.progressSummaryLock.Lock()
+	suite.progressSummaryReport.SpecReports = append(suite.progressSummaryReport.SpecReports, suite.currentSpecReport)
+	suite.progressSummaryLock.Unlock()
+	suite.emitProgressSummaryIfNeedBe()
+}
+
+/*
+startProgressSummaries emits a progress summary every ProgressSummaryInterval - even while a long-running (or hung) spec is still running - until the returned function is called.
+
+Summaries are also emitted as specs complete.  In both cases emitProgressSummaryIfNeedBe throttles the summaries to at most one per interval.
+*/
+func (suite *Suite) startProgressSummaries() func() {
+	if suite.config.ProgressSummaryInterval <= 0 {
+		return func() {}
+	}
+	// when running in parallel the suite's reporter is a NoopReporter and the server emits progress summaries for the aggregated run
+	if _, ok := suite.reporter.(reporters.ProgressSummaryReporter); !ok {
+		return func() {}
+	}
+	ticker := time.NewTicker(suite.config.ProgressSummaryInterval)
+	done, stopped := make(chan interface{}), make(chan interface{})
+	go func() {
+		defer close(stopped)
+		for {
+			select {
+			case <-ticker.C:
+				suite.emitProgressSummaryIfNeedBe()
+			case <-done:
+				return
+			}
+		}
+	}()
+	return func() {
+		ticker.Stop()
+		close(done)
+		<-stopped
+	}
+}
+
+func (suite *Suite) emitProgressSummaryIfNeedBe() {
+	if suite.config.ProgressSummaryInterval <= 0 {
+		return
+	}
+	progressSummaryReporter, ok := suite.reporter.(reporters.ProgressSummaryReporter)
+	if !ok {
+		return
+	}
+	suite.progressSummaryLock.Lock()
+	defer suite.progressSummaryLock.Unlock()
+	now := time.Now()
+	if now.Sub(suite.lastProgressSummary) < suite.config.ProgressSummaryInterval {
+		return
+	}
+	suite.lastProgressSummary = now
+	report := suite.progressSummaryReport
+	report.SpecReports = append(types.SpecReports{}, report.SpecReports...)
+	report.RunTime = now.Sub(report.StartTime)
+	progressSummaryReporter.EmitProgressSummary(report)
 }
 
 func (suite *Suite) runSpecs(description string, suiteLabels Labels, suitePath string, hasProgrammaticFocus bool, specs Specs) bool {
@@ -443,8 +566,11 @@ func (suite *Suite) runSpecs(description string, suiteLabels Labels, suitePath s
 		},
 		StartTime: time.Now(),
 	}
+	suite.progressSummaryReport = suite.report
+	suite.lastProgressSummary = suite.report.StartTime
 
 	suite.reporter.SuiteWillBegin(suite.report)
+	stopProgressSummaries := suite.startProgressSummaries()
 	if suite.isRunningInParallel() {
 		suite.client.PostSuiteWillBegin(suite.report)
 	}
@@ -464,6 +590,7 @@ func (suite *Suite) runSpecs(description string, suiteLabels Labels, suitePath s
 		if suite.isRunningInParallel() {
 			nextIndex = suite.client.FetchNextCounter
 		}
+		phaseIdx := 0
 
 		for {
 			groupedSpecIdx, err := nextIndex()
@@ -482,6 +609,18 @@ func (suite *Suite) runSpecs(description string, suiteLabels Labels, suitePath s
 				break
 			}
 
+			if len(suite.config.PhaseLabels) > 0 {
+				groupPhaseIdx := PhaseIndexForGroup(specs, groupedSpecIndices[groupedSpecIdx], suite.config.PhaseLabels)
+				if groupPhaseIdx > phaseIdx {
+					// we're entering a new phase - if an earlier phase failed, the specs in this phase (and any later phases) are skipped
+					if !suite.report.SuiteSucceeded && !suite.config.PhaseContinueOnFailure {
+						suite.skipLaterPhases = true
+					}
+					phaseIdx = groupPhaseIdx
+					suite.currentPhaseLabel = suite.config.PhaseLabels[phaseIdx-1]
+				}
+			}
+
 			// the complexity for running groups of specs is very high because of Ordered containers and FlakeAttempts
 			// we encapsulate that complexity in the notion of a Group that can run
 			// Group is really just an extension of suite so it gets passed a suite and has access to all its internals
@@ -489,12 +628,23 @@ func (suite *Suite) runSpecs(description string, suiteLabels Labels, suitePath s
 			newGroup(suite).run(specs.AtIndices(groupedSpecIndices[groupedSpecIdx]))
 		}
 
-		if specs.HasAnySpecsMarkedPending() && suite.config.FailOnPending {
+		// a dry run only reports which specs would run, so pending specs should not fail it
+		if specs.HasAnySpecsMarkedPending() && suite.config.FailOnPending && !suite.config.DryRun {
 			suite.report.SpecialSuiteFailureReasons = append(suite.report.SpecialSuiteFailureReasons, "Detected pending specs and --fail-on-pending is set")
 			suite.report.SuiteSucceeded = false
 		}
 	}
 
+	if suite.report.SuiteHasProgrammaticFocus && suite.config.FailOnFocused {
+		suite.report.SpecialSuiteFailureReasons = append(suite.report.SpecialSuiteFailureReasons, "Detected programmatic focus and --fail-on-focused is set")
+		suite.report.SuiteSucceeded = false
+	}
+
+	if suite.config.ExpectedSpecCount > 0 && len(specs) < suite.config.ExpectedSpecCount-suite.config.ExpectedSpecCountTolerance {
+		suite.report.SpecialSuiteFailureReasons = append(suite.report.SpecialSuiteFailureReasons, fmt.Sprintf("Found %d specs but --expected-spec-count is %d (tolerance %d)", len(specs), suite.config.ExpectedSpecCount, suite.config.ExpectedSpecCountTolerance))
+		suite.report.SuiteSucceeded = false
+	}
+
 	if ranBeforeSuite {
 		suite.runAfterSuiteCleanup(numSpecsThatWillBeRun)
 	}
@@ -512,6 +662,7 @@ func (suite *Suite) runSpecs(description string, suiteLabels Labels, suitePath s
 	}
 
 	suite.runReportSuiteNodesIfNeedBe(types.NodeTypeReportAfterSuite)
+	stopProgressSummaries()
 	suite.reporter.SuiteDidEnd(suite.report)
 	if suite.isRunningInParallel() {
 		suite.client.PostSuiteDidEnd(suite.report)
@@ -536,7 +687,7 @@ func (suite *Suite) runBeforeSuite(numSpecsThatWillBeRun int) {
 		suite.runSuiteNode(beforeSuiteNode)
 		if suite.currentSpecReport.State.Is(types.SpecStateSkipped) {
 			suite.report.SpecialSuiteFailureReasons = append(suite.report.SpecialSuiteFailureReasons, "Suite skipped in BeforeSuite")
-			suite.skipAll = true
+			suite.skipAll, suite.skipAllReason = true, "the suite was skipped in BeforeSuite"
 		}
 		suite.processCurrentSpecReport()
 	}
@@ -578,6 +729,19 @@ func (suite *Suite) runAfterSuiteCleanup(numSpecsThatWillBeRun int) {
 	}
 }
 
+// shouldInterceptOutputFor returns false if config.OutputInterceptorLabel is set and the spec does not have that label
+func (suite *Suite) shouldInterceptOutputFor(spec Spec) bool {
+	if suite.config.OutputInterceptorLabel == "" {
+		return true
+	}
+	for _, label := range UnionOfLabels(suite.report.SuiteLabels, spec.Nodes.UnionOfLabels()) {
+		if strings.EqualFold(label, suite.config.OutputInterceptorLabel) {
+			return true
+		}
+	}
+	return false
+}
+
 func (suite *Suite) reportEach(spec Spec, nodeType types.NodeType) {
 	nodes := spec.Nodes.WithType(nodeType)
 	if nodeType == types.NodeTypeReportAfterEach {
@@ -593,7 +757,7 @@ func (suite *Suite) reportEach(spec Spec, nodeType types.NodeType) {
 	for i := range nodes {
 		suite.writer.Truncate()
 		suite.outputInterceptor.StartInterceptingOutput()
-		report := suite.currentSpecReport
+		report := suite.outputRedactor.RedactSpecReport(suite.currentSpecReport)
 		nodes[i].Body = func(SpecContext) {
 			nodes[i].ReportEachBody(report)
 		}
@@ -93,15 +93,16 @@ func (s Reports) WithLeafNodeType(nodeTypes types.NodeType) Reports {
 }
 
 type FakeReporter struct {
-	Begin           types.Report
-	Will            Reports
-	Did             Reports
-	End             types.Report
-	ProgressReports []types.ProgressReport
-	ReportEntries   []types.ReportEntry
-	SpecEvents      []types.SpecEvent
-	Failures        []types.AdditionalFailure
-	lock            *sync.Mutex
+	Begin             types.Report
+	Will              Reports
+	Did               Reports
+	End               types.Report
+	ProgressReports   []types.ProgressReport
+	ProgressSummaries []types.Report
+	ReportEntries     []types.ReportEntry
+	SpecEvents        []types.SpecEvent
+	Failures          []types.AdditionalFailure
+	lock              *sync.Mutex
 }
 
 func NewFakeReporter() *FakeReporter {
@@ -138,6 +139,16 @@ func (r *FakeReporter) EmitProgressReport(progressReport types.ProgressReport) {
 	defer r.lock.Unlock()
 	r.ProgressReports = append(r.ProgressReports, progressReport)
 }
+func (r *FakeReporter) EmitProgressSummary(report types.Report) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.ProgressSummaries = append(r.ProgressSummaries, report)
+}
+func (r *FakeReporter) NumProgressSummaries() int {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	return len(r.ProgressSummaries)
+}
 func (r *FakeReporter) EmitFailure(state types.SpecState, failure types.Failure) {
 	r.lock.Lock()
 	defer r.lock.Unlock()
@@ -0,0 +1,83 @@
+package reporters
+
+import (
+	"sync"
+
+	"github.com/onsi/ginkgo/v2/types"
+)
+
+/*
+ChannelReporter pushes each completed SpecReport onto a buffered channel so that Go tooling (e.g. a live dashboard) can consume results as the suite runs.
+
+Wire it up using Ginkgo's reporting nodes and drain C() in a goroutine:
+
+	var channelReporter = reporters.NewChannelReporter(100)
+	var _ = BeforeSuite(func() { go dashboard.Consume(channelReporter.C()) })
+	var _ = ReportAfterEach(func(report SpecReport) { channelReporter.DidRun(report) })
+	var _ = AfterSuite(func() { channelReporter.Close() })
+
+Sends never block.  If the consumer falls behind and the buffer is full the SpecReport is dropped and counted - the number of dropped reports is available via Dropped().  Size the buffer accordingly if the consumer must see every spec.
+
+The channel is closed by Close or SuiteDidEnd, whichever is called first.  When running in parallel each process has its own ChannelReporter and only receives the specs that process runs.  Since ReportAfterSuite only runs on process #1, use AfterSuite (which runs on every process) to close the channel.
+*/
+type ChannelReporter struct {
+	lock    *sync.Mutex
+	c       chan types.SpecReport
+	closed  bool
+	dropped int
+}
+
+func NewChannelReporter(buffer int) *ChannelReporter {
+	return &ChannelReporter{
+		lock: &sync.Mutex{},
+		c:    make(chan types.SpecReport, buffer),
+	}
+}
+
+// C returns the channel the ChannelReporter sends SpecReports on
+func (r *ChannelReporter) C() <-chan types.SpecReport {
+	return r.c
+}
+
+// Dropped returns the number of SpecReports that were dropped because the channel's buffer was full
+func (r *ChannelReporter) Dropped() int {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	return r.dropped
+}
+
+// Close closes the channel.  Subsequent SpecReports are ignored and it is safe to call Close more than once.
+func (r *ChannelReporter) Close() {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	if !r.closed {
+		r.closed = true
+		close(r.c)
+	}
+}
+
+func (r *ChannelReporter) SuiteWillBegin(report types.Report) {}
+
+func (r *ChannelReporter) WillRun(report types.SpecReport) {}
+
+func (r *ChannelReporter) DidRun(report types.SpecReport) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	if r.closed {
+		return
+	}
+	select {
+	case r.c <- report:
+	default:
+		r.dropped += 1
+	}
+}
+
+func (r *ChannelReporter) SuiteDidEnd(report types.Report) {
+	r.Close()
+}
+
+func (r *ChannelReporter) EmitFailure(state types.SpecState, failure types.Failure) {}
+func (r *ChannelReporter) EmitProgressReport(progressReport types.ProgressReport)   {}
+func (r *ChannelReporter) EmitReportEntry(entry types.ReportEntry)                  {}
+func (r *ChannelReporter) EmitSpecEvent(event types.SpecEvent)                      {}
@@ -0,0 +1,68 @@
+package reporters_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/onsi/ginkgo/v2/reporters"
+	"github.com/onsi/ginkgo/v2/types"
+)
+
+var _ = Describe("ChannelReporter", func() {
+	var specReports types.SpecReports
+
+	BeforeEach(func() {
+		specReports = types.SpecReports{
+			S("A", cl0, types.SpecStatePassed),
+			S("B", cl1, types.SpecStateFailed, F("boom", cl1)),
+			S("C", cl2, types.SpecStatePending),
+		}
+	})
+
+	drain := func(c <-chan types.SpecReport) []string {
+		texts := []string{}
+		for report := range c {
+			texts = append(texts, report.LeafNodeText)
+		}
+		return texts
+	}
+
+	It("sends every spec exactly once and closes the channel when the suite ends", func() {
+		reporter := reporters.NewChannelReporter(10)
+		reporter.SuiteWillBegin(types.Report{})
+		received := make(chan []string)
+		go func() {
+			received <- drain(reporter.C())
+		}()
+		for _, specReport := range specReports {
+			reporter.WillRun(specReport)
+			reporter.DidRun(specReport)
+		}
+		reporter.SuiteDidEnd(types.Report{SpecReports: specReports})
+
+		Eventually(received).Should(Receive(Equal([]string{"A", "B", "C"})))
+		Ω(reporter.Dropped()).Should(Equal(0))
+	})
+
+	It("never blocks - it drops and counts reports when the buffer is full", func() {
+		reporter := reporters.NewChannelReporter(2)
+		for _, specReport := range specReports {
+			reporter.DidRun(specReport)
+		}
+		reporter.Close()
+
+		Ω(drain(reporter.C())).Should(Equal([]string{"A", "B"}))
+		Ω(reporter.Dropped()).Should(Equal(1))
+	})
+
+	It("ignores reports after it is closed and tolerates being closed more than once", func() {
+		reporter := reporters.NewChannelReporter(10)
+		reporter.DidRun(specReports[0])
+		reporter.Close()
+		reporter.DidRun(specReports[1])
+		reporter.SuiteDidEnd(types.Report{})
+
+		Ω(drain(reporter.C())).Should(Equal([]string{"A"}))
+		Ω(reporter.Dropped()).Should(Equal(0))
+	})
+})
@@ -90,7 +90,10 @@ func (r *DefaultReporter) SuiteWillBegin(report types.Report) {
 		r.emitBlock(strings.Repeat("=", bannerWidth))
 
 		out := r.f("Random Seed: {{bold}}%d{{/}}", report.SuiteConfig.RandomSeed)
-		if report.SuiteConfig.RandomizeAllSpecs {
+		if report.PreRunStats.SpecsThatWillRun == 1 {
+			// there is nothing to shuffle so don't suggest that the seed could matter
+			out += r.f(" - inconsequential as only one spec will run")
+		} else if report.SuiteConfig.RandomizeAllSpecs {
 			out += r.f(" - will randomize all specs")
 		}
 		r.emitBlock(out)
@@ -126,6 +129,9 @@ func (r *DefaultReporter) SuiteDidEnd(report types.Report) {
 			locationBlock := r.codeLocationBlock(specReport, highlightColor, false, true)
 			r.emitBlock(r.fi(1, highlightColor+"%s{{/}} %s", heading, locationBlock))
 		}
+		if r.conf.GroupFailureMessages {
+			r.emitFailureMessageGroups(failures)
+		}
 	}
 
 	//summarize the suite
@@ -170,6 +176,94 @@ func (r *DefaultReporter) SuiteDidEnd(report types.Report) {
 		r.emit(r.f("{{yellow}}{{bold}}%d Pending{{/}} | ", specs.CountWithState(types.SpecStatePending)))
 		r.emit(r.f("{{cyan}}{{bold}}%d Skipped{{/}}\n", specs.CountWithState(types.SpecStateSkipped)))
 	}
+
+	if report.SuiteConfig.ParallelTotal > 1 {
+		if process, runTime := report.SlowestParallelProcess(); process > 0 {
+			r.emitBlock(r.f("{{gray}}Slowest parallel process: #%d (%.3f seconds){{/}}", process, runTime.Seconds()))
+		}
+	}
+
+	if len(report.SuiteConfig.PhaseLabels) > 0 {
+		r.emitPhaseSummaries(specs, report.SuiteConfig.PhaseLabels)
+	}
+
+	if report.SuiteConfig.SmokeLabel != "" {
+		r.emitSmokeLabelCoverage(specs, report.SuiteConfig.SmokeLabel)
+	}
+
+	if r.conf.PendingAsWarning {
+		r.emitPendingSpecs(specs)
+	}
+}
+
+func (r *DefaultReporter) emitPendingSpecs(specs types.SpecReports) {
+	pending := specs.WithState(types.SpecStatePending)
+	if len(pending) == 0 {
+		return
+	}
+	if len(pending) > 1 {
+		r.emitBlock(r.f("{{yellow}}{{bold}}Warning: %d specs are pending (--pending-as-warning):{{/}}", len(pending)))
+	} else {
+		r.emitBlock(r.f("{{yellow}}{{bold}}Warning: 1 spec is pending (--pending-as-warning):{{/}}"))
+	}
+	for _, spec := range pending {
+		r.emitBlock(r.fi(1, "{{yellow}}%s{{/}} {{gray}}%s{{/}}", spec.FullText(), spec.LeafNodeLocation))
+	}
+}
+
+func (r *DefaultReporter) emitSmokeLabelCoverage(specs types.SpecReports, smokeLabel string) {
+	uncovered := specs.TopLevelContainersWithoutLabel(smokeLabel)
+	if len(uncovered) == 0 {
+		return
+	}
+	if len(uncovered) > 1 {
+		r.emitBlock(r.f("{{orange}}{{bold}}Warning: %d top-level containers have no specs labeled %s (--smoke-label):{{/}}", len(uncovered), smokeLabel))
+	} else {
+		r.emitBlock(r.f("{{orange}}{{bold}}Warning: 1 top-level container has no specs labeled %s (--smoke-label):{{/}}", smokeLabel))
+	}
+	for _, container := range uncovered {
+		r.emitBlock(r.fi(1, "{{orange}}%s{{/}} {{gray}}%s{{/}}", container.Text, container.CodeLocation))
+	}
+}
+
+func (r *DefaultReporter) emitFailureMessageGroups(failures types.SpecReports) {
+	groups := failures.GroupFailuresByMessage()
+	r.emitBlock("\n")
+	if len(groups) > 1 {
+		r.emitBlock(r.f("{{red}}{{bold}}Summarizing %d Distinct Failure Messages:{{/}}", len(groups)))
+	} else {
+		r.emitBlock(r.f("{{red}}{{bold}}Summarizing 1 Distinct Failure Message:{{/}}"))
+	}
+	for _, group := range groups {
+		count := "1 spec"
+		if len(group.SpecReports) > 1 {
+			count = fmt.Sprintf("%d specs", len(group.SpecReports))
+		}
+		r.emitBlock(r.fi(1, "{{red}}[%s]{{/}} %s", count, group.Message))
+	}
+}
+
+func (r *DefaultReporter) emitPhaseSummaries(specs types.SpecReports, phaseLabels []string) {
+	specsByPhase := map[string]types.SpecReports{}
+	for _, spec := range specs {
+		phase := spec.Phase(phaseLabels)
+		specsByPhase[phase] = append(specsByPhase[phase], spec)
+	}
+	for _, phase := range append([]string{""}, phaseLabels...) {
+		phaseSpecs, ok := specsByPhase[phase]
+		if !ok {
+			continue
+		}
+		heading := r.f("{{coral}}[%s]{{/}}", phase)
+		if phase == "" {
+			heading = r.f("{{coral}}[no phase label]{{/}}")
+		}
+		r.emit(r.fi(1, "%s ", heading))
+		r.emit(r.f("{{green}}%d Passed{{/}} | ", phaseSpecs.CountWithState(types.SpecStatePassed)))
+		r.emit(r.f("{{red}}%d Failed{{/}} | ", phaseSpecs.CountWithState(types.SpecStateFailureStates)))
+		r.emit(r.f("{{yellow}}%d Pending{{/}} | ", phaseSpecs.CountWithState(types.SpecStatePending)))
+		r.emit(r.f("{{cyan}}%d Skipped{{/}}\n", phaseSpecs.CountWithState(types.SpecStateSkipped)))
+	}
 }
 
 func (r *DefaultReporter) WillRun(report types.SpecReport) {
@@ -183,6 +277,9 @@ func (r *DefaultReporter) WillRun(report types.SpecReport) {
 }
 
 func (r *DefaultReporter) DidRun(report types.SpecReport) {
+	if r.conf.SilenceSkips && report.State.Is(types.SpecStateSkipped) {
+		return
+	}
 	v := r.conf.Verbosity()
 	inParallel := report.RunningInParallel
 
@@ -255,6 +352,9 @@ func (r *DefaultReporter) DidRun(report types.SpecReport) {
 		if v.Is(types.VerbosityLevelVeryVerbose) || (v.Is(types.VerbosityLevelVerbose) && report.Failure.Message != "") {
 			header, reportHasContent = "S [SKIPPED]", true
 		}
+		if reportHasContent && report.SkipReason != "" {
+			header = fmt.Sprintf("%s - %s", header, report.SkipReason)
+		}
 	default:
 		header = fmt.Sprintf("%s [%s]", header, r.humanReadableState(report.State))
 		if report.MaxMustPassRepeatedly > 1 {
@@ -439,6 +539,17 @@ func (r *DefaultReporter) EmitProgressReport(report types.ProgressReport) {
 	r.emitDelimiter(1)
 }
 
+func (r *DefaultReporter) EmitProgressSummary(report types.Report) {
+	specs := report.SpecReports.WithLeafNodeType(types.NodeTypeIt)
+	completed := len(specs) - specs.CountWithState(types.SpecStatePending|types.SpecStateSkipped)
+	out := r.f("{{bold}}Progress Summary:{{/}} %d of %d specs completed in %.3f seconds -- ", completed, report.PreRunStats.SpecsThatWillRun, report.RunTime.Seconds())
+	out += r.f("{{green}}%d Passed{{/}} | ", specs.CountWithState(types.SpecStatePassed))
+	out += r.f("{{red}}%d Failed{{/}} | ", specs.CountWithState(types.SpecStateFailureStates))
+	out += r.f("{{yellow}}%d Pending{{/}} | ", specs.CountWithState(types.SpecStatePending))
+	out += r.f("{{cyan}}%d Skipped{{/}}", specs.CountWithState(types.SpecStateSkipped))
+	r.emitBlock(out)
+}
+
 func (r *DefaultReporter) emitProgressReport(indent uint, emitGinkgoWriterOutput bool, report types.ProgressReport) {
 	if report.Message != "" {
 		r.emitBlock(r.fi(indent, report.Message+"\n"))
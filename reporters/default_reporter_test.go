@@ -103,6 +103,8 @@ func AF(state types.SpecState, options ...interface{}) types.AdditionalFailure {
 
 type STD string
 type GW string
+type ParallelProcess int
+type SkipReason string
 
 // convenience helper to quickly make SpecReports
 func S(options ...interface{}) types.SpecReport {
@@ -145,8 +147,12 @@ func S(options ...interface{}) types.SpecReport {
 			report.MaxMustPassRepeatedly = int(x)
 		case STD:
 			report.CapturedStdOutErr = string(x)
+		case SkipReason:
+			report.SkipReason = string(x)
 		case GW:
 			report.CapturedGinkgoWriterOutput = string(x)
+		case ParallelProcess:
+			report.ParallelProcess = int(x)
 		case types.Failure:
 			report.Failure = x
 		case types.AdditionalFailure:
@@ -176,6 +182,7 @@ const (
 	VeryVerbose
 	FullTrace
 	ShowNodeEvents
+	SilenceSkips
 
 	Parallel //used in the WillRun => DidRun specs to capture behavior when running in parallel
 )
@@ -201,6 +208,9 @@ func (cf ConfigFlag) String() string {
 	if cf.Has(ShowNodeEvents) {
 		out = append(out, "show-node-events")
 	}
+	if cf.Has(SilenceSkips) {
+		out = append(out, "silence-skips")
+	}
 	if cf.Has(Parallel) {
 		out = append(out, "parallel")
 	}
@@ -226,6 +236,7 @@ func C(flags ...ConfigFlag) types.ReporterConfig {
 		VeryVerbose:    f.Has(VeryVerbose),
 		FullTrace:      f.Has(FullTrace),
 		ShowNodeEvents: f.Has(ShowNodeEvents),
+		SilenceSkips:   f.Has(SilenceSkips),
 	}
 }
 
@@ -463,6 +474,19 @@ var _ = Describe("DefaultReporter", func() {
 			"Will run {{bold}}15{{/}} of {{bold}}20{{/}} specs",
 			"",
 		),
+		Entry("When only one spec will run",
+			C(),
+			types.Report{
+				SuiteDescription: "My Suite", SuitePath: "/path/to/suite", PreRunStats: types.PreRunStats{SpecsThatWillRun: 1, TotalSpecs: 20},
+				SuiteConfig: types.SuiteConfig{RandomSeed: 17, ParallelTotal: 1, RandomizeAllSpecs: true},
+			},
+			"Running Suite: My Suite - /path/to/suite",
+			"========================================",
+			"Random Seed: {{bold}}17{{/}} - inconsequential as only one spec will run",
+			"",
+			"Will run {{bold}}1{{/}} of {{bold}}20{{/}} specs",
+			"",
+		),
 		Entry("when configured to run in parallel",
 			C(),
 			types.Report{
@@ -869,6 +893,18 @@ var _ = Describe("DefaultReporter", func() {
 				"{{gray}}cl0.go:12{{/}}",
 				DELIMITER,
 				""),
+			Case(Succinct|SilenceSkips, Normal|SilenceSkips, Verbose|SilenceSkips, VeryVerbose|SilenceSkips, VeryVerbose|Parallel|SilenceSkips),
+		),
+		Entry("a test that was skipped by a filter",
+			S(types.NodeTypeIt, "A", types.SpecStateSkipped, cl0, SkipReason("does not match --focus")),
+			Case(Succinct, Normal, Succinct|Parallel, Normal|Parallel, Verbose, Verbose|Parallel,
+				"{{cyan}}S{{/}}"),
+			Case(VeryVerbose,
+				"{{cyan}}S [SKIPPED] - does not match --focus{{/}}",
+				"{{cyan}}{{bold}}A{{/}}",
+				"{{gray}}cl0.go:12{{/}}",
+				DELIMITER,
+				""),
 		),
 		Entry("a user-skipped test",
 			S(types.NodeTypeIt, "A", types.SpecStateSkipped, cl0,
@@ -1837,6 +1873,50 @@ var _ = Describe("DefaultReporter", func() {
 			"{{green}}{{bold}}SUCCESS!{{/}} -- {{green}}{{bold}}3 Passed{{/}} | {{red}}{{bold}}0 Failed{{/}} | {{yellow}}{{bold}}2 Pending{{/}} | {{cyan}}{{bold}}3 Skipped{{/}}",
 			"",
 		),
+		Entry("the suite runs in phases",
+			C(),
+			types.Report{
+				SuiteSucceeded: false,
+				SuiteConfig:    types.SuiteConfig{PhaseLabels: []string{"unit", "integration"}},
+				PreRunStats:    types.PreRunStats{TotalSpecs: 5, SpecsThatWillRun: 5},
+				RunTime:        time.Minute,
+				SpecReports: types.SpecReports{
+					S(types.SpecStatePassed),
+					S(types.SpecStatePassed, Label("UNIT")), S("the unit test", cl0, types.SpecStateFailed, F("boom", cl0), Label("unit")),
+					S(types.SpecStateSkipped, Label("integration")), S(types.SpecStateSkipped, Label("unit", "integration")),
+				},
+			},
+			"",
+			"{{red}}{{bold}}Summarizing 1 Failure:{{/}}",
+			"  {{red}}[FAIL]{{/}} {{red}}{{bold}}the unit test{{/}} {{coral}}[unit]{{/}}",
+			"  {{gray}}cl0.go:12{{/}}",
+			"",
+			"{{red}}{{bold}}Ran 3 of 5 Specs in 60.000 seconds{{/}}",
+			"{{red}}{{bold}}FAIL!{{/}} -- {{green}}{{bold}}2 Passed{{/}} | {{red}}{{bold}}1 Failed{{/}} | {{yellow}}{{bold}}0 Pending{{/}} | {{cyan}}{{bold}}2 Skipped{{/}}",
+			"  {{coral}}[no phase label]{{/}} {{green}}1 Passed{{/}} | {{red}}0 Failed{{/}} | {{yellow}}0 Pending{{/}} | {{cyan}}0 Skipped{{/}}",
+			"  {{coral}}[unit]{{/}} {{green}}1 Passed{{/}} | {{red}}1 Failed{{/}} | {{yellow}}0 Pending{{/}} | {{cyan}}0 Skipped{{/}}",
+			"  {{coral}}[integration]{{/}} {{green}}0 Passed{{/}} | {{red}}0 Failed{{/}} | {{yellow}}0 Pending{{/}} | {{cyan}}2 Skipped{{/}}",
+			"",
+		),
+		Entry("the suite runs in parallel",
+			C(),
+			types.Report{
+				SuiteSucceeded: true,
+				SuiteConfig:    types.SuiteConfig{ParallelTotal: 3},
+				PreRunStats:    types.PreRunStats{TotalSpecs: 4, SpecsThatWillRun: 4},
+				RunTime:        time.Minute,
+				SpecReports: types.SpecReports{
+					S(types.NodeTypeBeforeSuite, ParallelProcess(1), 2*time.Second),
+					S(ParallelProcess(1), time.Second), S(ParallelProcess(2), 3*time.Second),
+					S(ParallelProcess(3), time.Second), S(ParallelProcess(3), time.Second),
+				},
+			},
+			"",
+			"{{green}}{{bold}}Ran 4 of 4 Specs in 60.000 seconds{{/}}",
+			"{{green}}{{bold}}SUCCESS!{{/}} -- {{green}}{{bold}}4 Passed{{/}} | {{red}}{{bold}}0 Failed{{/}} | {{yellow}}{{bold}}0 Pending{{/}} | {{cyan}}{{bold}}0 Skipped{{/}}",
+			"{{gray}}Slowest parallel process: #1 (3.000 seconds){{/}}",
+			"",
+		),
 		Entry("the suite passes and has flaky specs",
 			C(),
 			types.Report{
@@ -1946,6 +2026,76 @@ var _ = Describe("DefaultReporter", func() {
 			"{{red}}{{bold}}FAIL!{{/}} -- {{green}}{{bold}}6 Passed{{/}} | {{red}}{{bold}}7 Failed{{/}} | {{light-yellow}}{{bold}}2 Flaked{{/}} | {{light-yellow}}{{bold}}2 Repeated{{/}} | {{yellow}}{{bold}}2 Pending{{/}} | {{cyan}}{{bold}}3 Skipped{{/}}",
 			"",
 		),
+		Entry("the suite is configured with a smoke label",
+			C(),
+			types.Report{
+				SuiteSucceeded: true,
+				SuiteConfig:    types.SuiteConfig{SmokeLabel: "smoke"},
+				PreRunStats:    types.PreRunStats{TotalSpecs: 3, SpecsThatWillRun: 1},
+				RunTime:        time.Minute,
+				SpecReports: types.SpecReports{
+					S(CTS("A"), CLS(cl0), types.SpecStatePassed, Label("smoke")),
+					S(CTS("B"), CLS(cl1), types.SpecStateSkipped),
+					S(CTS("C"), CLS(cl2), types.SpecStateSkipped),
+				},
+			},
+			"",
+			"{{green}}{{bold}}Ran 1 of 3 Specs in 60.000 seconds{{/}}",
+			"{{green}}{{bold}}SUCCESS!{{/}} -- {{green}}{{bold}}1 Passed{{/}} | {{red}}{{bold}}0 Failed{{/}} | {{yellow}}{{bold}}0 Pending{{/}} | {{cyan}}{{bold}}2 Skipped{{/}}",
+			"{{orange}}{{bold}}Warning: 2 top-level containers have no specs labeled smoke (--smoke-label):{{/}}",
+			"  {{orange}}B{{/}} {{gray}}cl1.go:37{{/}}",
+			"  {{orange}}C{{/}} {{gray}}cl2.go:80{{/}}",
+			"",
+		),
+		Entry("the suite has pending specs and is configured to report them as warnings",
+			types.ReporterConfig{NoColor: true, PendingAsWarning: true},
+			types.Report{
+				SuiteSucceeded: true,
+				PreRunStats:    types.PreRunStats{TotalSpecs: 3, SpecsThatWillRun: 1},
+				RunTime:        time.Minute,
+				SpecReports: types.SpecReports{
+					S(CTS("A"), "B", cl0, types.SpecStatePassed),
+					S(CTS("A"), "C", cl1, types.SpecStatePending),
+					S("D", cl2, types.SpecStatePending),
+				},
+			},
+			"",
+			"{{green}}{{bold}}Ran 1 of 3 Specs in 60.000 seconds{{/}}",
+			"{{green}}{{bold}}SUCCESS!{{/}} -- {{green}}{{bold}}1 Passed{{/}} | {{red}}{{bold}}0 Failed{{/}} | {{yellow}}{{bold}}2 Pending{{/}} | {{cyan}}{{bold}}0 Skipped{{/}}",
+			"{{yellow}}{{bold}}Warning: 2 specs are pending (--pending-as-warning):{{/}}",
+			"  {{yellow}}A C{{/}} {{gray}}cl1.go:37{{/}}",
+			"  {{yellow}}D{{/}} {{gray}}cl2.go:80{{/}}",
+			"",
+		),
+		Entry("the suite fails and is configured to group failure messages",
+			types.ReporterConfig{NoColor: true, GroupFailureMessages: true},
+			types.Report{
+				SuiteSucceeded: false,
+				PreRunStats:    types.PreRunStats{TotalSpecs: 3, SpecsThatWillRun: 3},
+				RunTime:        time.Minute,
+				SpecReports: types.SpecReports{
+					S("A", cl0, types.SpecStateFailed, F("connection\nrefused", cl0)),
+					S("B", cl1, types.SpecStateFailed, F("connection refused", cl1)),
+					S("C", cl2, types.SpecStateFailed, F("boom", cl2)),
+				},
+			},
+			"",
+			"{{red}}{{bold}}Summarizing 3 Failures:{{/}}",
+			"  {{red}}[FAIL]{{/}} {{red}}{{bold}}A{{/}}",
+			"  {{gray}}cl0.go:12{{/}}",
+			"  {{red}}[FAIL]{{/}} {{red}}{{bold}}B{{/}}",
+			"  {{gray}}cl1.go:37{{/}}",
+			"  {{red}}[FAIL]{{/}} {{red}}{{bold}}C{{/}}",
+			"  {{gray}}cl2.go:80{{/}}",
+			"",
+			"{{red}}{{bold}}Summarizing 2 Distinct Failure Messages:{{/}}",
+			"  {{red}}[2 specs]{{/}} connection refused",
+			"  {{red}}[1 spec]{{/}} boom",
+			"",
+			"{{red}}{{bold}}Ran 3 of 3 Specs in 60.000 seconds{{/}}",
+			"{{red}}{{bold}}FAIL!{{/}} -- {{green}}{{bold}}0 Passed{{/}} | {{red}}{{bold}}3 Failed{{/}} | {{yellow}}{{bold}}0 Pending{{/}} | {{cyan}}{{bold}}0 Skipped{{/}}",
+			"",
+		),
 		Entry("the suite fails with failed suite setups",
 			C(),
 			types.Report{
@@ -2496,6 +2646,41 @@ var _ = Describe("DefaultReporter", func() {
 			""),
 	)
 
+	DescribeTable("EmitProgressSummary",
+		func(conf types.ReporterConfig, report types.Report, expected ...any) {
+			reporter := reporters.NewDefaultReporterUnderTest(conf, buf)
+			reporter.EmitProgressSummary(report)
+			Expect(string(buf.Contents())).Should(MatchLines(expected...))
+		},
+		Entry("summarizing the specs that have completed so far",
+			C(),
+			types.Report{
+				PreRunStats: types.PreRunStats{TotalSpecs: 10, SpecsThatWillRun: 8},
+				RunTime:     time.Minute,
+				SpecReports: types.SpecReports{
+					S(types.NodeTypeBeforeSuite),
+					S(types.SpecStatePassed), S(types.SpecStatePassed), S(types.SpecStateFailed),
+					S(types.SpecStatePending), S(types.SpecStateSkipped),
+				},
+			},
+			"{{bold}}Progress Summary:{{/}} 3 of 8 specs completed in 60.000 seconds -- {{green}}2 Passed{{/}} | {{red}}1 Failed{{/}} | {{yellow}}1 Pending{{/}} | {{cyan}}1 Skipped{{/}}",
+			"",
+		),
+		Entry("not counting pending specs towards the specs that will run",
+			C(),
+			types.Report{
+				PreRunStats: types.PreRunStats{TotalSpecs: 4, SpecsThatWillRun: 3},
+				RunTime:     time.Minute,
+				SpecReports: types.SpecReports{
+					S(types.SpecStatePassed), S(types.SpecStatePassed), S(types.SpecStateFailed),
+					S(types.SpecStatePending),
+				},
+			},
+			"{{bold}}Progress Summary:{{/}} 3 of 3 specs completed in 60.000 seconds -- {{green}}2 Passed{{/}} | {{red}}1 Failed{{/}} | {{yellow}}1 Pending{{/}} | {{cyan}}0 Skipped{{/}}",
+			"",
+		),
+	)
+
 	DescribeTable("EmitFailure",
 		func(conf types.ReporterConfig, af types.AdditionalFailure, expected ...any) {
 			reporter := reporters.NewDefaultReporterUnderTest(conf, buf)
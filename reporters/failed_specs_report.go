@@ -0,0 +1,48 @@
+package reporters
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"strings"
+
+	"github.com/onsi/ginkgo/v2/types"
+)
+
+/*
+GenerateFailedSpecsReport writes the full text of every failed spec in the report to the passed in destination, one spec per line.
+
+Pass the file to --failed-specs-from to rerun only those specs.  Use types.LoadFailedSpecsReport to read the file back.
+*/
+func GenerateFailedSpecsReport(report types.Report, dst string) error {
+	out := &strings.Builder{}
+	seen := map[string]bool{}
+	for _, spec := range report.SpecReports.WithLeafNodeType(types.NodeTypeIt).WithState(types.SpecStateFailureStates) {
+		text := types.FailedSpecsReportLine(spec.FullText())
+		if seen[text] {
+			continue
+		}
+		seen[text] = true
+		out.WriteString(text + "\n")
+	}
+	return writeReportFile(out.String(), dst)
+}
+
+// MergeAndCleanupFailedSpecsReports concatenates the failed specs reports in sources into dst and removes the sources
+func MergeAndCleanupFailedSpecsReports(sources []string, dst string) ([]string, error) {
+	messages := []string{}
+	merged := []byte{}
+	for _, source := range sources {
+		data, err := os.ReadFile(source)
+		if err != nil {
+			messages = append(messages, fmt.Sprintf("Could not open %s:\n%s", source, err.Error()))
+			continue
+		}
+		os.Remove(source)
+		merged = append(merged, data...)
+	}
+	if err := os.MkdirAll(path.Dir(dst), 0770); err != nil {
+		return messages, err
+	}
+	return messages, os.WriteFile(dst, merged, 0666)
+}
@@ -0,0 +1,54 @@
+package reporters_test
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/onsi/ginkgo/v2/reporters"
+	"github.com/onsi/ginkgo/v2/types"
+)
+
+var _ = Describe("FailedSpecsReport", func() {
+	var folderPath string
+
+	BeforeEach(func() {
+		folderPath = filepath.Join(fmt.Sprintf("test_outputs_%d", GinkgoParallelProcess()))
+		DeferCleanup(os.RemoveAll, folderPath)
+	})
+
+	It("records the full text of each failed spec, once, and can load it back", func() {
+		report := types.Report{SpecReports: types.SpecReports{
+			S(types.NodeTypeBeforeSuite, cl0, types.SpecStateFailed),
+			S(CTS("A"), "B", cl1, types.SpecStatePassed),
+			S(CTS("A"), "C", cl2, types.SpecStateFailed),
+			S(CTS("A"), "D\nE", cl3, types.SpecStatePanicked),
+			S(CTS("A"), "C", cl2, types.SpecStateTimedout),
+			S(CTS("A"), "F", cl4, types.SpecStateSkipped),
+		}}
+		filePath := filepath.Join(folderPath, "failed.txt")
+		Ω(reporters.GenerateFailedSpecsReport(report, filePath)).Should(Succeed())
+
+		content, err := os.ReadFile(filePath)
+		Ω(err).ShouldNot(HaveOccurred())
+		Ω(string(content)).Should(Equal("A C\nA D E\n"))
+
+		Ω(types.LoadFailedSpecsReport(filePath)).Should(Equal([]string{"A C", "A D E"}))
+	})
+
+	It("merges reports and cleans up the sources", func() {
+		sources := []string{filepath.Join(folderPath, "a.txt"), filepath.Join(folderPath, "b.txt"), filepath.Join(folderPath, "missing.txt")}
+		Ω(reporters.GenerateFailedSpecsReport(types.Report{SpecReports: types.SpecReports{S("A", types.SpecStateFailed)}}, sources[0])).Should(Succeed())
+		Ω(reporters.GenerateFailedSpecsReport(types.Report{SpecReports: types.SpecReports{S("B", types.SpecStateFailed)}}, sources[1])).Should(Succeed())
+
+		dst := filepath.Join(folderPath, "merged.txt")
+		messages, err := reporters.MergeAndCleanupFailedSpecsReports(sources, dst)
+		Ω(err).ShouldNot(HaveOccurred())
+		Ω(messages).Should(HaveLen(1))
+		Ω(sources[0]).ShouldNot(BeAnExistingFile())
+		Ω(types.LoadFailedSpecsReport(dst)).Should(Equal([]string{"A", "B"}))
+	})
+})
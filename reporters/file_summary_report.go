@@ -0,0 +1,79 @@
+package reporters
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"sort"
+	"time"
+
+	"github.com/onsi/ginkgo/v2/types"
+)
+
+// FileSummary captures the outcome of all the specs defined in a single file
+type FileSummary struct {
+	FileName string
+	Passed   int
+	Failed   int
+	Pending  int
+	Skipped  int
+	RunTime  time.Duration
+}
+
+// FileSummaries buckets the specs in report by the file that defines them (i.e. the file containing the spec's It)
+//
+// Suite-level nodes (e.g. BeforeSuite) are not included.  Files with failures sort first, then files are sorted by name.
+func FileSummaries(report types.Report) []FileSummary {
+	summaries := []FileSummary{}
+	indices := map[string]int{}
+	for _, spec := range report.SpecReports.WithLeafNodeType(types.NodeTypeIt) {
+		fileName := spec.LeafNodeLocation.FileName
+		idx, ok := indices[fileName]
+		if !ok {
+			idx = len(summaries)
+			indices[fileName] = idx
+			summaries = append(summaries, FileSummary{FileName: fileName})
+		}
+		switch {
+		case spec.State.Is(types.SpecStatePassed):
+			summaries[idx].Passed += 1
+		case spec.State.Is(types.SpecStateFailureStates):
+			summaries[idx].Failed += 1
+		case spec.State.Is(types.SpecStatePending):
+			summaries[idx].Pending += 1
+		case spec.State.Is(types.SpecStateSkipped):
+			summaries[idx].Skipped += 1
+		}
+		summaries[idx].RunTime += spec.RunTime
+	}
+
+	sort.SliceStable(summaries, func(i, j int) bool {
+		if (summaries[i].Failed > 0) != (summaries[j].Failed > 0) {
+			return summaries[i].Failed > 0
+		}
+		return summaries[i].FileName < summaries[j].FileName
+	})
+
+	return summaries
+}
+
+// GenerateFileSummaryReport produces a plain-text report at the passed in destination with one line per file summarizing the specs defined in that file
+func GenerateFileSummaryReport(report types.Report, dst string) error {
+	if err := os.MkdirAll(path.Dir(dst), 0770); err != nil {
+		return err
+	}
+	f, err := os.Create(dst)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	for _, summary := range FileSummaries(report) {
+		_, err = fmt.Fprintf(f, "%s: %d Passed | %d Failed | %d Pending | %d Skipped (%.3f seconds)\n",
+			summary.FileName, summary.Passed, summary.Failed, summary.Pending, summary.Skipped, summary.RunTime.Seconds())
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,68 @@
+package reporters_test
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/onsi/ginkgo/v2/reporters"
+	"github.com/onsi/ginkgo/v2/types"
+)
+
+var _ = Describe("FileSummaryReport", func() {
+	var report types.Report
+
+	BeforeEach(func() {
+		report = types.Report{
+			SuiteDescription: "My Suite",
+			SuitePath:        "/path/to/suite",
+			SpecReports: types.SpecReports{
+				S(types.NodeTypeBeforeSuite, cl3, types.SpecStateFailed, time.Second),
+				S("A", cl0, types.SpecStatePassed, time.Second),
+				S("B", cl0, types.SpecStatePending, time.Duration(0)),
+				S("C", cl1, types.SpecStatePassed, 2*time.Second),
+				S("D", cl1, types.SpecStateTimedout, 3*time.Second),
+				S("E", cl2, types.SpecStateSkipped, time.Duration(0)),
+				S("F", cl1, types.SpecStatePanicked, time.Second),
+			},
+		}
+	})
+
+	Describe("FileSummaries", func() {
+		It("buckets the specs by file, putting files with failures first", func() {
+			Ω(reporters.FileSummaries(report)).Should(Equal([]reporters.FileSummary{
+				{FileName: "cl1.go", Passed: 1, Failed: 2, RunTime: 6 * time.Second},
+				{FileName: "cl0.go", Passed: 1, Pending: 1, RunTime: time.Second},
+				{FileName: "cl2.go", Skipped: 1},
+			}))
+		})
+	})
+
+	Describe("GenerateFileSummaryReport", func() {
+		var folderPath string
+		var filePath string
+
+		BeforeEach(func() {
+			folderPath = filepath.Join(fmt.Sprintf("test_outputs_%d", GinkgoParallelProcess()))
+			fileName := fmt.Sprintf("report-%d", GinkgoParallelProcess())
+			filePath = filepath.Join(folderPath, fileName)
+
+			Ω(reporters.GenerateFileSummaryReport(report, filePath)).Should(Succeed())
+			DeferCleanup(os.RemoveAll, folderPath)
+		})
+
+		It("writes one line per file", func() {
+			content, err := os.ReadFile(filePath)
+			Ω(err).ShouldNot(HaveOccurred())
+			Ω(string(content)).Should(Equal(
+				"cl1.go: 1 Passed | 2 Failed | 0 Pending | 0 Skipped (6.000 seconds)\n" +
+					"cl0.go: 1 Passed | 0 Failed | 1 Pending | 0 Skipped (1.000 seconds)\n" +
+					"cl2.go: 0 Passed | 0 Failed | 0 Pending | 1 Skipped (0.000 seconds)\n",
+			))
+		})
+	})
+})
@@ -11,10 +11,13 @@ The schema used for the generated JUnit xml file was adapted from https://llg.cu
 package reporters
 
 import (
+	"bufio"
+	"encoding/json"
 	"encoding/xml"
 	"fmt"
 	"os"
 	"path"
+	"path/filepath"
 	"strings"
 
 	"github.com/onsi/ginkgo/v2/config"
@@ -40,6 +43,10 @@ type JunitReportConfig struct {
 
 	// Enable OmitSuiteSetupNodes to prevent the creation of testcase entries for setup nodes
 	OmitSuiteSetupNodes bool
+
+	// Spec States for which no testcase entry should be emitted.  The testsuite counts still reflect all specs.
+	// set this to types.SpecStatePassed|types.SpecStateSkipped|types.SpecStatePending to only emit failing specs
+	OmitTestCasesForSpecState types.SpecState
 }
 
 type JUnitTestSuites struct {
@@ -216,6 +223,8 @@ func GenerateJUnitReportWithConfig(report types.Report, dst string, config Junit
 			message := "skipped"
 			if spec.Failure.Message != "" {
 				message += " - " + spec.Failure.Message
+			} else if spec.SkipReason != "" {
+				message += " - " + spec.SkipReason
 			}
 			test.Skipped = &JUnitSkipped{Message: message}
 			suite.Skipped += 1
@@ -274,6 +283,9 @@ func GenerateJUnitReportWithConfig(report types.Report, dst string, config Junit
 			suite.Errors += 1
 		}
 
+		if spec.State.Is(config.OmitTestCasesForSpecState) {
+			continue
+		}
 		suite.TestCases = append(suite.TestCases, test)
 	}
 
@@ -341,6 +353,74 @@ func MergeAndCleanupJUnitReports(sources []string, dst string) ([]string, error)
 	return messages, f.Close()
 }
 
+/*
+AppendToPartialJUnitReport records the spec report in a partial report file that lives alongside dst.  Each parallel process writes to its own partial report file.
+
+When the Ginkgo CLI runs a suite with --junit-report set, Ginkgo records each spec as it completes.  If the suite process crashes before it can generate its JUnit report (e.g. due to a segfault in cgo code) the Ginkgo CLI calls RecoverPartialJUnitReport to assemble the specs that did complete into a JUnit report.
+*/
+func AppendToPartialJUnitReport(spec types.SpecReport, dst string, process int) error {
+	if err := os.MkdirAll(path.Dir(dst), 0770); err != nil {
+		return err
+	}
+	f, err := os.OpenFile(fmt.Sprintf("%s.partial-%d", dst, process), os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0666)
+	if err != nil {
+		return err
+	}
+	err = json.NewEncoder(f).Encode(spec)
+	if err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// CleanupPartialJUnitReports removes any partial report files written by AppendToPartialJUnitReport for dst
+func CleanupPartialJUnitReports(dst string) error {
+	partials, err := filepath.Glob(dst + ".partial-*")
+	if err != nil {
+		return err
+	}
+	for _, partial := range partials {
+		if err := os.Remove(partial); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+/*
+RecoverPartialJUnitReport generates a JUnit report at dst from the partial report files written by AppendToPartialJUnitReport.  The passed-in report should describe the suite - the recorded specs are appended to its SpecReports.
+
+Nothing is generated if a report already exists at dst.  In either case the partial report files are removed.  RecoverPartialJUnitReport returns true if it generated a report.
+*/
+func RecoverPartialJUnitReport(report types.Report, dst string) (bool, error) {
+	defer CleanupPartialJUnitReports(dst)
+	if _, err := os.Stat(dst); err == nil {
+		return false, nil
+	}
+	partials, err := filepath.Glob(dst + ".partial-*")
+	if err != nil || len(partials) == 0 {
+		return false, err
+	}
+	for _, partial := range partials {
+		f, err := os.Open(partial)
+		if err != nil {
+			return false, err
+		}
+		scanner := bufio.NewScanner(f)
+		scanner.Buffer(nil, 64*1024*1024)
+		for scanner.Scan() {
+			var spec types.SpecReport
+			// a crash can leave a truncated final line behind - skip it
+			if json.Unmarshal(scanner.Bytes(), &spec) == nil {
+				report.SpecReports = append(report.SpecReports, spec)
+			}
+		}
+		f.Close()
+	}
+	return true, GenerateJUnitReport(report, dst)
+}
+
 func failureDescriptionForUnstructuredReporters(spec types.SpecReport) string {
 	out := &strings.Builder{}
 	NewDefaultReporter(types.ReporterConfig{NoColor: true, VeryVerbose: true}, out).emitFailure(0, spec.State, spec.Failure, true)
@@ -374,6 +374,101 @@ var _ = Describe("JunitReport", func() {
 		})
 	})
 
+	Describe("when configured to only emit testcases for failing specs", func() {
+		var generated reporters.JUnitTestSuites
+
+		BeforeEach(func() {
+			fname := fmt.Sprintf("./report-%d", GinkgoParallelProcess())
+			Ω(reporters.GenerateJUnitReportWithConfig(report, fname, reporters.JunitReportConfig{
+				OmitTestCasesForSpecState: types.SpecStatePassed | types.SpecStateSkipped | types.SpecStatePending,
+			})).Should(Succeed())
+			DeferCleanup(os.Remove, fname)
+
+			generated = reporters.JUnitTestSuites{}
+			f, err := os.Open(fname)
+			Ω(err).ShouldNot(HaveOccurred())
+			Ω(xml.NewDecoder(f).Decode(&generated)).Should(Succeed())
+		})
+
+		It("omits the testcases but still reports the true totals", func() {
+			Ω(generated.Tests).Should(Equal(5))
+			Ω(generated.Disabled).Should(Equal(1))
+			Ω(generated.Errors).Should(Equal(1))
+			Ω(generated.Failures).Should(Equal(1))
+
+			suite := generated.TestSuites[0]
+			Ω(suite.Tests).Should(Equal(5))
+			Ω(suite.Disabled).Should(Equal(1))
+			Ω(suite.Errors).Should(Equal(1))
+			Ω(suite.Failures).Should(Equal(1))
+
+			Ω(suite.TestCases).Should(HaveLen(2))
+			Ω(suite.TestCases[0].Status).Should(Equal("timedout"))
+			Ω(suite.TestCases[1].Status).Should(Equal("panicked"))
+		})
+	})
+
+	Describe("recovering partial reports", func() {
+		var folderPath, filePath string
+
+		BeforeEach(func() {
+			folderPath = filepath.Join(fmt.Sprintf("test_outputs_%d", GinkgoParallelProcess()))
+			filePath = filepath.Join(folderPath, "report.xml")
+			DeferCleanup(os.RemoveAll, folderPath)
+
+			Ω(reporters.AppendToPartialJUnitReport(report.SpecReports[0], filePath, 1)).Should(Succeed())
+			Ω(reporters.AppendToPartialJUnitReport(report.SpecReports[1], filePath, 2)).Should(Succeed())
+			Ω(reporters.AppendToPartialJUnitReport(report.SpecReports[2], filePath, 1)).Should(Succeed())
+		})
+
+		partials := func() []string {
+			matches, err := filepath.Glob(filePath + ".partial-*")
+			Ω(err).ShouldNot(HaveOccurred())
+			return matches
+		}
+
+		It("writes a partial report file per process", func() {
+			Ω(partials()).Should(ConsistOf(filePath+".partial-1", filePath+".partial-2"))
+		})
+
+		It("assembles the recorded specs into a JUnit report and cleans up", func() {
+			f, err := os.OpenFile(filePath+".partial-2", os.O_APPEND|os.O_WRONLY, 0666)
+			Ω(err).ShouldNot(HaveOccurred())
+			f.WriteString(`{"LeafNodeText": "trunc`)
+			f.Close()
+
+			recovered, err := reporters.RecoverPartialJUnitReport(types.Report{SuitePath: "/path/to/suite", SpecialSuiteFailureReasons: []string{"crashed"}}, filePath)
+			Ω(err).ShouldNot(HaveOccurred())
+			Ω(recovered).Should(BeTrue())
+			Ω(partials()).Should(BeEmpty())
+
+			generated := reporters.JUnitTestSuites{}
+			f, err = os.Open(filePath)
+			Ω(err).ShouldNot(HaveOccurred())
+			Ω(xml.NewDecoder(f).Decode(&generated)).Should(Succeed())
+			f.Close()
+
+			Ω(generated.Tests).Should(Equal(3))
+			suite := generated.TestSuites[0]
+			Ω(suite.TestCases).Should(HaveLen(3))
+			Ω(suite.Properties.WithName("SuiteSucceeded")).Should(Equal("false"))
+			Ω(suite.Properties.WithName("SpecialSuiteFailureReason")).Should(Equal("crashed"))
+		})
+
+		It("does nothing but clean up if a report already exists", func() {
+			Ω(reporters.GenerateJUnitReport(report, filePath)).Should(Succeed())
+			recovered, err := reporters.RecoverPartialJUnitReport(types.Report{}, filePath)
+			Ω(err).ShouldNot(HaveOccurred())
+			Ω(recovered).Should(BeFalse())
+			Ω(partials()).Should(BeEmpty())
+		})
+
+		It("cleans up the partial report files", func() {
+			Ω(reporters.CleanupPartialJUnitReports(filePath)).Should(Succeed())
+			Ω(partials()).Should(BeEmpty())
+		})
+	})
+
 	Describe("when configured to write the report inside a folder", func() {
 		var folderPath string
 		var filePath string
@@ -0,0 +1,121 @@
+package reporters
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/onsi/ginkgo/v2/types"
+)
+
+// MarkdownReport renders a Markdown summary of the report suitable for posting as, e.g., a pull request comment
+//
+// The summary line includes the spec counts and random seed.  Passing specs are only counted; failing specs (including any failing suite-level nodes) are listed in a table with their location, duration, and failure message.
+func MarkdownReport(report types.Report) string {
+	out := &strings.Builder{}
+	specs := report.SpecReports.WithLeafNodeType(types.NodeTypeIt)
+
+	status := "SUCCESS!"
+	if !report.SuiteSucceeded {
+		status = "FAIL!"
+	}
+	counts := []string{
+		fmt.Sprintf("%d Passed", specs.CountWithState(types.SpecStatePassed)),
+		fmt.Sprintf("%d Failed", specs.CountWithState(types.SpecStateFailureStates)),
+	}
+	if specs.CountOfFlakedSpecs() > 0 {
+		counts = append(counts, fmt.Sprintf("%d Flaked", specs.CountOfFlakedSpecs()))
+	}
+	counts = append(counts,
+		fmt.Sprintf("%d Pending", specs.CountWithState(types.SpecStatePending)),
+		fmt.Sprintf("%d Skipped", specs.CountWithState(types.SpecStateSkipped)),
+	)
+	fmt.Fprintf(out, "**%s** %s %s -- Random Seed: `%d` -- %.3f seconds\n",
+		markdownEscape(report.SuiteDescription), status, strings.Join(counts, " | "), report.SuiteConfig.RandomSeed, report.RunTime.Seconds())
+	if len(report.SpecialSuiteFailureReasons) > 0 {
+		fmt.Fprintf(out, "\n%s\n", markdownEscape(strings.Join(report.SpecialSuiteFailureReasons, ", ")))
+	}
+
+	failures := report.SpecReports.WithState(types.SpecStateFailureStates)
+	if len(failures) == 0 {
+		return out.String()
+	}
+
+	out.WriteString("\n| Spec | Location | Duration | Failure |\n| --- | --- | --- | --- |\n")
+	for _, spec := range failures {
+		name := fmt.Sprintf("[%s]", spec.LeafNodeType)
+		if spec.FullText() != "" {
+			name += " " + spec.FullText()
+		}
+		message := spec.Failure.Message
+		if spec.State == types.SpecStatePanicked {
+			message = strings.TrimSpace(message + " " + spec.Failure.ForwardedPanic)
+		}
+		fmt.Fprintf(out, "| %s | `%s` | %.3fs | %s: %s |\n",
+			markdownEscape(name), spec.LeafNodeLocation, spec.RunTime.Seconds(), spec.State, markdownEscape(message))
+	}
+
+	return out.String()
+}
+
+// GenerateMarkdownReport writes the Markdown summary rendered by MarkdownReport to the passed in destination
+func GenerateMarkdownReport(report types.Report, dst string) error {
+	return writeReportFile(MarkdownReport(report), dst)
+}
+
+func writeReportFile(content string, dst string) error {
+	if err := os.MkdirAll(path.Dir(dst), 0770); err != nil {
+		return err
+	}
+	f, err := os.Create(dst)
+	if err != nil {
+		return err
+	}
+	_, err = f.WriteString(content)
+	if err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// PendingSpecsChecklist renders the pending specs in the report as a Markdown checklist, sorted by location
+//
+// Each item includes the spec's full text, its labels, and its code location.  Pending specs do not carry a reason so give them descriptive text (or labels) if you want them to be actionable.
+func PendingSpecsChecklist(report types.Report) string {
+	pending := report.SpecReports.WithLeafNodeType(types.NodeTypeIt).WithState(types.SpecStatePending)
+	sort.SliceStable(pending, func(i, j int) bool {
+		if pending[i].LeafNodeLocation.FileName != pending[j].LeafNodeLocation.FileName {
+			return pending[i].LeafNodeLocation.FileName < pending[j].LeafNodeLocation.FileName
+		}
+		return pending[i].LeafNodeLocation.LineNumber < pending[j].LeafNodeLocation.LineNumber
+	})
+
+	out := &strings.Builder{}
+	fmt.Fprintf(out, "**%s**: %d Pending\n", report.SuiteDescription, len(pending))
+	if len(pending) > 0 {
+		out.WriteString("\n")
+	}
+	for _, spec := range pending {
+		item := spec.FullText()
+		if labels := spec.Labels(); len(labels) > 0 {
+			item += " [" + strings.Join(labels, ", ") + "]"
+		}
+		fmt.Fprintf(out, "- [ ] %s (`%s`)\n", strings.ReplaceAll(strings.TrimSpace(item), "\n", " "), spec.LeafNodeLocation)
+	}
+	return out.String()
+}
+
+// GeneratePendingSpecsChecklist writes the Markdown checklist rendered by PendingSpecsChecklist to the passed in destination
+func GeneratePendingSpecsChecklist(report types.Report, dst string) error {
+	return writeReportFile(PendingSpecsChecklist(report), dst)
+}
+
+// markdownEscape ensures s renders on a single line inside a Markdown table cell
+func markdownEscape(s string) string {
+	s = strings.ReplaceAll(s, "|", "\\|")
+	s = strings.ReplaceAll(strings.TrimSpace(s), "\n", "<br>")
+	return s
+}
@@ -0,0 +1,110 @@
+package reporters_test
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/onsi/ginkgo/v2/reporters"
+	"github.com/onsi/ginkgo/v2/types"
+)
+
+var _ = Describe("MarkdownReport", func() {
+	var report types.Report
+
+	BeforeEach(func() {
+		report = types.Report{
+			SuiteDescription: "My Suite",
+			SuitePath:        "/path/to/suite",
+			SuiteSucceeded:   false,
+			SuiteConfig:      types.SuiteConfig{RandomSeed: 17},
+			RunTime:          time.Minute,
+			SpecReports: types.SpecReports{
+				S(types.NodeTypeBeforeSuite, cl0, types.SpecStatePassed),
+				S(CTS("A"), "B", cl1, types.SpecStatePassed),
+				S(CTS("A"), "C", cl2, types.SpecStateFailed, 2*time.Second, F("expected | to be\nfine", cl2)),
+				S(CTS("A"), "D", cl3, types.SpecStatePanicked, F("Test Panicked", cl3, ForwardedPanic("bam"))),
+				S(CTS("A"), "E", cl4, types.SpecStatePending),
+				S(CTS("A"), "F", cl4, types.SpecStateSkipped),
+			},
+		}
+	})
+
+	It("renders a summary line and a table of failing specs", func() {
+		Ω(reporters.MarkdownReport(report)).Should(Equal(
+			"**My Suite** FAIL! 1 Passed | 2 Failed | 1 Pending | 1 Skipped -- Random Seed: `17` -- 60.000 seconds\n" +
+				"\n" +
+				"| Spec | Location | Duration | Failure |\n" +
+				"| --- | --- | --- | --- |\n" +
+				"| [It] A C | `cl2.go:80` | 2.000s | failed: expected \\| to be<br>fine |\n" +
+				"| [It] A D | `cl3.go:103` | 1.000s | panicked: Test Panicked bam |\n",
+		))
+	})
+
+	It("omits the table when nothing failed", func() {
+		report.SuiteSucceeded = true
+		report.SpecReports = types.SpecReports{S("B", cl1), S("C", cl2, 2, FlakeAttempts(3))}
+		Ω(reporters.MarkdownReport(report)).Should(Equal(
+			"**My Suite** SUCCESS! 2 Passed | 0 Failed | 1 Flaked | 0 Pending | 0 Skipped -- Random Seed: `17` -- 60.000 seconds\n",
+		))
+	})
+
+	It("includes any special suite failure reasons", func() {
+		report.SpecialSuiteFailureReasons = []string{"Detected Programmatic Focus"}
+		report.SpecReports = types.SpecReports{S("B", cl1)}
+		Ω(reporters.MarkdownReport(report)).Should(Equal(
+			"**My Suite** FAIL! 1 Passed | 0 Failed | 0 Pending | 0 Skipped -- Random Seed: `17` -- 60.000 seconds\n" +
+				"\n" +
+				"Detected Programmatic Focus\n",
+		))
+	})
+
+	Describe("PendingSpecsChecklist", func() {
+		It("lists the pending specs, sorted by location", func() {
+			report.SpecReports = append(report.SpecReports,
+				S(CTS("A"), "G", cl1, types.SpecStatePending, Labels{"slow", "db"}),
+				S(CTS("A"), "H", cl0, types.SpecStatePending),
+			)
+			Ω(reporters.PendingSpecsChecklist(report)).Should(Equal(
+				"**My Suite**: 3 Pending\n" +
+					"\n" +
+					"- [ ] A H (`cl0.go:12`)\n" +
+					"- [ ] A G [slow, db] (`cl1.go:37`)\n" +
+					"- [ ] A E (`cl4.go:144`)\n",
+			))
+		})
+
+		It("renders an empty checklist when nothing is pending", func() {
+			report.SpecReports = types.SpecReports{S("B", cl1)}
+			Ω(reporters.PendingSpecsChecklist(report)).Should(Equal("**My Suite**: 0 Pending\n"))
+		})
+
+		It("writes the checklist to disk", func() {
+			folderPath := filepath.Join(fmt.Sprintf("test_outputs_%d", GinkgoParallelProcess()))
+			filePath := filepath.Join(folderPath, fmt.Sprintf("pending-%d.md", GinkgoParallelProcess()))
+			Ω(reporters.GeneratePendingSpecsChecklist(report, filePath)).Should(Succeed())
+			DeferCleanup(os.RemoveAll, folderPath)
+
+			content, err := os.ReadFile(filePath)
+			Ω(err).ShouldNot(HaveOccurred())
+			Ω(string(content)).Should(Equal(reporters.PendingSpecsChecklist(report)))
+		})
+	})
+
+	Describe("GenerateMarkdownReport", func() {
+		It("writes the report to disk", func() {
+			folderPath := filepath.Join(fmt.Sprintf("test_outputs_%d", GinkgoParallelProcess()))
+			filePath := filepath.Join(folderPath, fmt.Sprintf("report-%d.md", GinkgoParallelProcess()))
+			Ω(reporters.GenerateMarkdownReport(report, filePath)).Should(Succeed())
+			DeferCleanup(os.RemoveAll, folderPath)
+
+			content, err := os.ReadFile(filePath)
+			Ω(err).ShouldNot(HaveOccurred())
+			Ω(string(content)).Should(Equal(reporters.MarkdownReport(report)))
+		})
+	})
+})
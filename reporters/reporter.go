@@ -17,6 +17,15 @@ type Reporter interface {
 	EmitSpecEvent(event types.SpecEvent)
 }
 
+/*
+ProgressSummaryReporter is an optional interface that Reporters can implement to receive intermediate summaries of a running suite.
+
+When SuiteConfig.ProgressSummaryInterval is set, Ginkgo will call EmitProgressSummary every interval with a partial report containing the specs that have completed so far - even if no spec has completed since the last summary.  EmitProgressSummary is called from its own goroutine, concurrently with the other Reporter methods.  The report passed to SuiteDidEnd remains authoritative.
+*/
+type ProgressSummaryReporter interface {
+	EmitProgressSummary(report types.Report)
+}
+
 type NoopReporter struct{}
 
 func (n NoopReporter) SuiteWillBegin(report types.Report)                       {}
@@ -0,0 +1,124 @@
+package reporters
+
+import (
+	"encoding/json"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/onsi/ginkgo/v2/types"
+)
+
+// SocketReporterTimeout bounds how long the SocketReporter will wait to connect to, or write to, its socket
+var SocketReporterTimeout = 5 * time.Second
+
+// SocketReporterMessage is the newline-delimited JSON message the SocketReporter writes to its socket
+type SocketReporterMessage struct {
+	// Event is one of SuiteWillBegin, DidRun, or SuiteDidEnd
+	Event string
+
+	// Report is set for SuiteWillBegin and SuiteDidEnd events
+	Report *types.Report `json:",omitempty"`
+
+	// SpecReport is set for DidRun events
+	SpecReport *types.SpecReport `json:",omitempty"`
+}
+
+/*
+SocketReporter streams results to a TCP or Unix socket as newline-delimited JSON SocketReporterMessages.
+
+Wire it up using Ginkgo's reporting nodes:
+
+	var socketReporter = reporters.NewSocketReporter("tcp", "localhost:9000")
+	var _ = ReportBeforeSuite(func(report Report) { socketReporter.SuiteWillBegin(report) })
+	var _ = ReportAfterEach(func(report SpecReport) { socketReporter.DidRun(report) })
+	var _ = ReportAfterSuite("socket reporter", func(report Report) { socketReporter.SuiteDidEnd(report) })
+	var _ = AfterSuite(func() {
+		if GinkgoParallelProcess() > 1 {
+			socketReporter.Close()
+		}
+	})
+
+When running in parallel each process opens its own connection and streams the specs it runs.  ReportBeforeSuite and ReportAfterSuite only run on process #1, so the final SuiteDidEnd message (which includes the aggregated report for all processes) is only sent once.  SuiteDidEnd closes process #1's connection - the other processes must call Close (e.g. in an AfterSuite, which runs on every process) or their connections stay open until the process exits.
+
+The SocketReporter never fails the suite.  If it cannot connect, or if a write fails, it stops sending and records the error - which is available via Err().
+*/
+type SocketReporter struct {
+	network string
+	address string
+
+	lock   *sync.Mutex
+	conn   net.Conn
+	enc    *json.Encoder
+	err    error
+	closed bool
+}
+
+func NewSocketReporter(network string, address string) *SocketReporter {
+	return &SocketReporter{
+		network: network,
+		address: address,
+		lock:    &sync.Mutex{},
+	}
+}
+
+// Err returns the first error the SocketReporter encountered, if any
+func (r *SocketReporter) Err() error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	return r.err
+}
+
+func (r *SocketReporter) SuiteWillBegin(report types.Report) {
+	r.send(SocketReporterMessage{Event: "SuiteWillBegin", Report: &report})
+}
+
+func (r *SocketReporter) WillRun(report types.SpecReport) {}
+
+func (r *SocketReporter) DidRun(report types.SpecReport) {
+	r.send(SocketReporterMessage{Event: "DidRun", SpecReport: &report})
+}
+
+func (r *SocketReporter) SuiteDidEnd(report types.Report) {
+	r.send(SocketReporterMessage{Event: "SuiteDidEnd", Report: &report})
+	r.Close()
+}
+
+// Close closes the connection.  Subsequent messages are dropped and it is safe to call Close more than once.
+func (r *SocketReporter) Close() {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.closed = true
+	if r.conn != nil {
+		r.conn.Close()
+		r.conn, r.enc = nil, nil
+	}
+}
+
+func (r *SocketReporter) EmitFailure(state types.SpecState, failure types.Failure) {}
+func (r *SocketReporter) EmitProgressReport(progressReport types.ProgressReport)   {}
+func (r *SocketReporter) EmitReportEntry(entry types.ReportEntry)                  {}
+func (r *SocketReporter) EmitSpecEvent(event types.SpecEvent)                      {}
+
+func (r *SocketReporter) send(message SocketReporterMessage) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	if r.err != nil || r.closed {
+		return
+	}
+	if r.conn == nil {
+		conn, err := net.DialTimeout(r.network, r.address, SocketReporterTimeout)
+		if err != nil {
+			r.err = err
+			return
+		}
+		r.conn, r.enc = conn, json.NewEncoder(conn)
+	}
+	r.conn.SetWriteDeadline(time.Now().Add(SocketReporterTimeout))
+	// json.Encoder terminates each message with a newline
+	if err := r.enc.Encode(message); err != nil {
+		r.err = err
+		r.conn.Close()
+		r.conn, r.enc = nil, nil
+	}
+}
@@ -0,0 +1,115 @@
+package reporters_test
+
+import (
+	"encoding/json"
+	"net"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/onsi/ginkgo/v2/reporters"
+	"github.com/onsi/ginkgo/v2/types"
+)
+
+var _ = Describe("SocketReporter", func() {
+	var report types.Report
+
+	BeforeEach(func() {
+		report = types.Report{
+			SuiteDescription: "My Suite",
+			SuitePath:        "/path/to/suite",
+			PreRunStats:      types.PreRunStats{SpecsThatWillRun: 2, TotalSpecs: 2},
+			SpecReports: types.SpecReports{
+				S("A", cl0, types.SpecStatePassed),
+				S("B", cl1, types.SpecStateFailed, F("boom", cl1)),
+			},
+		}
+	})
+
+	Context("when a listener is available", func() {
+		var listener net.Listener
+		var messages chan reporters.SocketReporterMessage
+
+		BeforeEach(func() {
+			var err error
+			listener, err = net.Listen("tcp", "127.0.0.1:0")
+			Ω(err).ShouldNot(HaveOccurred())
+			DeferCleanup(listener.Close)
+
+			messages = make(chan reporters.SocketReporterMessage, 10)
+			go func() {
+				defer GinkgoRecover()
+				defer close(messages)
+				conn, err := listener.Accept()
+				if err != nil {
+					return
+				}
+				defer conn.Close()
+				dec := json.NewDecoder(conn)
+				for {
+					var message reporters.SocketReporterMessage
+					if dec.Decode(&message) != nil {
+						return
+					}
+					messages <- message
+				}
+			}()
+		})
+
+		It("streams newline-delimited JSON for each event and closes the connection at the end", func() {
+			reporter := reporters.NewSocketReporter("tcp", listener.Addr().String())
+			reporter.SuiteWillBegin(report)
+			reporter.DidRun(report.SpecReports[0])
+			reporter.DidRun(report.SpecReports[1])
+			reporter.SuiteDidEnd(report)
+			Ω(reporter.Err()).ShouldNot(HaveOccurred())
+
+			var received []reporters.SocketReporterMessage
+			for message := range messages {
+				received = append(received, message)
+			}
+			Ω(received).Should(HaveLen(4))
+			Ω(received[0].Event).Should(Equal("SuiteWillBegin"))
+			Ω(received[0].Report.SuiteDescription).Should(Equal("My Suite"))
+			Ω(received[1].Event).Should(Equal("DidRun"))
+			Ω(received[1].SpecReport.LeafNodeText).Should(Equal("A"))
+			Ω(received[2].SpecReport.State).Should(Equal(types.SpecStateFailed))
+			Ω(received[2].SpecReport.Failure.Message).Should(Equal("boom"))
+			Ω(received[3].Event).Should(Equal("SuiteDidEnd"))
+			Ω(received[3].Report.SpecReports).Should(HaveLen(2))
+		})
+
+		It("closes the connection when Close is called and drops any subsequent messages", func() {
+			reporter := reporters.NewSocketReporter("tcp", listener.Addr().String())
+			reporter.DidRun(report.SpecReports[0])
+			reporter.Close()
+			reporter.Close()
+			reporter.DidRun(report.SpecReports[1])
+			Ω(reporter.Err()).ShouldNot(HaveOccurred())
+
+			var received []reporters.SocketReporterMessage
+			for message := range messages {
+				received = append(received, message)
+			}
+			Ω(received).Should(HaveLen(1))
+			Ω(received[0].SpecReport.LeafNodeText).Should(Equal("A"))
+		})
+	})
+
+	Context("when the listener cannot be reached", func() {
+		It("records the error without panicking and stops trying to send", func() {
+			listener, err := net.Listen("tcp", "127.0.0.1:0")
+			Ω(err).ShouldNot(HaveOccurred())
+			address := listener.Addr().String()
+			listener.Close()
+
+			reporter := reporters.NewSocketReporter("tcp", address)
+			Ω(func() {
+				reporter.SuiteWillBegin(report)
+				reporter.DidRun(report.SpecReports[0])
+				reporter.SuiteDidEnd(report)
+			}).ShouldNot(Panic())
+			Ω(reporter.Err()).Should(HaveOccurred())
+		})
+	})
+})
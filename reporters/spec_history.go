@@ -0,0 +1,145 @@
+package reporters
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"sort"
+
+	"github.com/onsi/ginkgo/v2/types"
+)
+
+type SpecHistoryConfig struct {
+	// MaxOutcomes is the number of recent outcomes retained for each spec.  Defaults to 10.
+	MaxOutcomes int
+}
+
+// SpecHistoryEntry captures the recent outcomes of a single spec
+type SpecHistoryEntry struct {
+	// FullText and LeafNodeLocation are taken from the most recent run of the spec.  LeafNodeLocation is relative to the suite's directory.
+	FullText         string
+	LeafNodeLocation types.CodeLocation
+
+	// Outcomes lists the spec's recent outcomes, oldest first.  Each outcome is either types.SpecStatePassed or one of the types.SpecStateFailureStates.
+	Outcomes []types.SpecState
+}
+
+// SpecHistory maps a spec's LocationID onto its recent outcomes
+type SpecHistory map[string]SpecHistoryEntry
+
+// Streak returns the most recent outcome and the number of consecutive runs that ended with that outcome
+func (entry SpecHistoryEntry) Streak() (types.SpecState, int) {
+	if len(entry.Outcomes) == 0 {
+		return types.SpecStateInvalid, 0
+	}
+	last := entry.Outcomes[len(entry.Outcomes)-1]
+	n := 0
+	for i := len(entry.Outcomes) - 1; i >= 0; i-- {
+		if entry.Outcomes[i].Is(types.SpecStateFailureStates) != last.Is(types.SpecStateFailureStates) {
+			break
+		}
+		n += 1
+	}
+	return last, n
+}
+
+// NumFailures returns the number of recent outcomes that were failures
+func (entry SpecHistoryEntry) NumFailures() int {
+	n := 0
+	for _, outcome := range entry.Outcomes {
+		if outcome.Is(types.SpecStateFailureStates) {
+			n += 1
+		}
+	}
+	return n
+}
+
+// Summary describes the spec's recent outcomes - e.g. "passed last 10 times" or "failed 3 of last 5"
+func (entry SpecHistoryEntry) Summary() string {
+	_, streak := entry.Streak()
+	numFailures := entry.NumFailures()
+	switch {
+	case streak == len(entry.Outcomes) && numFailures == 0:
+		return fmt.Sprintf("passed last %d %s", streak, pluralizedTimes(streak))
+	case streak == len(entry.Outcomes):
+		return fmt.Sprintf("failed last %d %s", streak, pluralizedTimes(streak))
+	default:
+		return fmt.Sprintf("failed %d of last %d", numFailures, len(entry.Outcomes))
+	}
+}
+
+// Concerning returns the entries for specs that failed at least once in their recent outcomes.  The specs with the most failures are listed first.
+func (history SpecHistory) Concerning() []SpecHistoryEntry {
+	out := []SpecHistoryEntry{}
+	for _, entry := range history {
+		if entry.NumFailures() > 0 {
+			out = append(out, entry)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].NumFailures() != out[j].NumFailures() {
+			return out[i].NumFailures() > out[j].NumFailures()
+		}
+		return out[i].FullText < out[j].FullText
+	})
+	return out
+}
+
+/*
+UpdateSpecHistory loads the spec history stored at dst (if any), records the outcome of every spec in the report that ran, and writes the updated history back to dst.
+
+Specs are keyed by their LocationID so a spec's history survives edits to its description but not moves to a new location.  The LocationID is computed against report.SuitePath so the history carries over from one checkout (or CI workspace) to the next.  Specs that were skipped or pending are not recorded.
+
+Call UpdateSpecHistory in a ReportAfterSuite and use the returned history's Concerning method to surface specs that have recently failed.
+*/
+func UpdateSpecHistory(report types.Report, dst string, config SpecHistoryConfig) (SpecHistory, error) {
+	maxOutcomes := config.MaxOutcomes
+	if maxOutcomes <= 0 {
+		maxOutcomes = 10
+	}
+
+	history := SpecHistory{}
+	data, err := os.ReadFile(dst)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return nil, err
+	}
+	if err == nil {
+		if err := json.Unmarshal(data, &history); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, spec := range report.SpecReports.WithLeafNodeType(types.NodeTypeIt) {
+		if !spec.State.Is(types.SpecStatePassed | types.SpecStateFailureStates) {
+			continue
+		}
+		id := spec.LocationID(report.SuitePath)
+		entry := history[id]
+		entry.FullText = spec.FullText()
+		entry.LeafNodeLocation = relativeCodeLocation(report.SuitePath, spec.LeafNodeLocation)
+		entry.Outcomes = append(entry.Outcomes, spec.State)
+		if len(entry.Outcomes) > maxOutcomes {
+			entry.Outcomes = entry.Outcomes[len(entry.Outcomes)-maxOutcomes:]
+		}
+		history[id] = entry
+	}
+
+	if err := os.MkdirAll(path.Dir(dst), 0770); err != nil {
+		return nil, err
+	}
+	data, err = json.MarshalIndent(history, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return history, os.WriteFile(dst, data, 0666)
+}
+
+func pluralizedTimes(n int) string {
+	if n == 1 {
+		return "time"
+	}
+	return "times"
+}
@@ -0,0 +1,107 @@
+package reporters_test
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/onsi/ginkgo/v2/reporters"
+	"github.com/onsi/ginkgo/v2/types"
+)
+
+var _ = Describe("SpecHistory", func() {
+	var filePath string
+
+	run := func(states ...types.SpecState) reporters.SpecHistory {
+		report := types.Report{SpecReports: types.SpecReports{
+			S(types.NodeTypeBeforeSuite, cl0, types.SpecStatePassed),
+			S(CTS("A"), CLS(cl0), "B", cl1, states[0]),
+			S(CTS("A"), CLS(cl0), "C", cl2, states[1]),
+			S(CTS("A"), CLS(cl0), "D", cl3, states[2]),
+		}}
+		history, err := reporters.UpdateSpecHistory(report, filePath, reporters.SpecHistoryConfig{MaxOutcomes: 5})
+		Ω(err).ShouldNot(HaveOccurred())
+		return history
+	}
+
+	BeforeEach(func() {
+		folderPath := filepath.Join(fmt.Sprintf("test_outputs_%d", GinkgoParallelProcess()))
+		filePath = filepath.Join(folderPath, "history.json")
+		DeferCleanup(os.RemoveAll, folderPath)
+	})
+
+	It("records the outcomes of the specs that ran across invocations, keyed by LocationID", func() {
+		run(types.SpecStatePassed, types.SpecStateFailed, types.SpecStateSkipped)
+		history := run(types.SpecStatePassed, types.SpecStatePanicked, types.SpecStatePending)
+
+		Ω(history).Should(HaveLen(2))
+		entry := history[S(CTS("A"), CLS(cl0), "B", cl1).LocationID("")]
+		Ω(entry.FullText).Should(Equal("A B"))
+		Ω(entry.LeafNodeLocation).Should(Equal(cl1))
+		Ω(entry.Outcomes).Should(Equal([]types.SpecState{types.SpecStatePassed, types.SpecStatePassed}))
+		Ω(history[S(CTS("A"), CLS(cl0), "C", cl2).LocationID("")].Outcomes).Should(Equal([]types.SpecState{types.SpecStateFailed, types.SpecStatePanicked}))
+
+		_, err := os.Stat(filePath)
+		Ω(err).ShouldNot(HaveOccurred())
+	})
+
+	It("only retains the most recent outcomes", func() {
+		for i := 0; i < 6; i++ {
+			run(types.SpecStatePassed, types.SpecStatePassed, types.SpecStatePassed)
+		}
+		history := run(types.SpecStateFailed, types.SpecStatePassed, types.SpecStatePassed)
+		Ω(history[S(CTS("A"), CLS(cl0), "B", cl1).LocationID("")].Outcomes).Should(Equal([]types.SpecState{
+			types.SpecStatePassed, types.SpecStatePassed, types.SpecStatePassed, types.SpecStatePassed, types.SpecStateFailed,
+		}))
+	})
+
+	It("summarizes streaks and surfaces the specs that have recently failed", func() {
+		run(types.SpecStatePassed, types.SpecStateFailed, types.SpecStatePassed)
+		run(types.SpecStateFailed, types.SpecStateFailed, types.SpecStatePassed)
+		history := run(types.SpecStatePassed, types.SpecStateTimedout, types.SpecStatePassed)
+
+		concerning := history.Concerning()
+		Ω(concerning).Should(HaveLen(2))
+		Ω(concerning[0].FullText).Should(Equal("A C"))
+		Ω(concerning[0].Summary()).Should(Equal("failed last 3 times"))
+		state, streak := concerning[0].Streak()
+		Ω(state).Should(Equal(types.SpecStateTimedout))
+		Ω(streak).Should(Equal(3))
+
+		Ω(concerning[1].FullText).Should(Equal("A B"))
+		Ω(concerning[1].Summary()).Should(Equal("failed 1 of last 3"))
+		state, streak = concerning[1].Streak()
+		Ω(state).Should(Equal(types.SpecStatePassed))
+		Ω(streak).Should(Equal(1))
+
+		Ω(history[S(CTS("A"), CLS(cl0), "D", cl3).LocationID("")].Summary()).Should(Equal("passed last 3 times"))
+	})
+
+	It("keys specs by locations relative to the suite so the history carries over between checkouts", func() {
+		runIn := func(suitePath string, state types.SpecState) reporters.SpecHistory {
+			report := types.Report{SuitePath: suitePath, SpecReports: types.SpecReports{
+				S(CTS("A"), CLS(types.CodeLocation{FileName: suitePath + "/a_test.go", LineNumber: 1}), "B", types.CodeLocation{FileName: suitePath + "/a_test.go", LineNumber: 4}, state),
+			}}
+			history, err := reporters.UpdateSpecHistory(report, filePath, reporters.SpecHistoryConfig{})
+			Ω(err).ShouldNot(HaveOccurred())
+			return history
+		}
+		runIn("/checkout/one/suite", types.SpecStatePassed)
+		history := runIn("/ci/workspace/suite", types.SpecStateFailed)
+
+		id := S(CTS("A"), CLS(types.CodeLocation{FileName: "a_test.go", LineNumber: 1}), "B", types.CodeLocation{FileName: "a_test.go", LineNumber: 4}).LocationID("")
+		Ω(history).Should(HaveLen(1))
+		Ω(history[id].Outcomes).Should(Equal([]types.SpecState{types.SpecStatePassed, types.SpecStateFailed}))
+		Ω(history[id].LeafNodeLocation).Should(Equal(types.CodeLocation{FileName: "a_test.go", LineNumber: 4}))
+	})
+
+	It("errors if the history file cannot be decoded", func() {
+		Ω(os.MkdirAll(filepath.Dir(filePath), 0770)).Should(Succeed())
+		Ω(os.WriteFile(filePath, []byte("not json"), 0666)).Should(Succeed())
+		_, err := reporters.UpdateSpecHistory(types.Report{}, filePath, reporters.SpecHistoryConfig{})
+		Ω(err).Should(HaveOccurred())
+	})
+})
@@ -0,0 +1,66 @@
+package reporters
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/onsi/ginkgo/v2/types"
+)
+
+/*
+SpecList renders every spec in the report - including specs that were skipped, filtered out, or marked pending - as one line per spec, sorted by location.  The output is deterministic and is intended to be checked in as a golden file so that review diffs show exactly which specs were added, removed, or renamed.
+
+Each line contains the spec's ID, its code location, its full text, and its labels.  Code locations are made relative to report.SuitePath and the ID is the spec's LocationID(report.SuitePath), so the list does not change from one checkout to the next.
+
+SpecList is typically paired with PreviewSpecs, which builds the report without running any specs.
+*/
+func SpecList(report types.Report) string {
+	specs := report.SpecReports.WithLeafNodeType(types.NodeTypeIt)
+	lines := make([]specListLine, len(specs))
+	for i, spec := range specs {
+		id := spec.LocationID(report.SuitePath)
+		spec.LeafNodeLocation = relativeCodeLocation(report.SuitePath, spec.LeafNodeLocation)
+		text := strings.ReplaceAll(spec.FullText(), "\n", " ")
+		if labels := spec.Labels(); len(labels) > 0 {
+			text += " [" + strings.Join(labels, ", ") + "]"
+		}
+		lines[i] = specListLine{location: spec.LeafNodeLocation, line: fmt.Sprintf("%s %s %s\n", id, spec.LeafNodeLocation, text)}
+	}
+	sort.SliceStable(lines, func(i, j int) bool {
+		if lines[i].location.FileName != lines[j].location.FileName {
+			return lines[i].location.FileName < lines[j].location.FileName
+		}
+		if lines[i].location.LineNumber != lines[j].location.LineNumber {
+			return lines[i].location.LineNumber < lines[j].location.LineNumber
+		}
+		return lines[i].line < lines[j].line
+	})
+
+	out := &strings.Builder{}
+	for _, line := range lines {
+		out.WriteString(line.line)
+	}
+	return out.String()
+}
+
+// GenerateSpecList writes the spec list rendered by SpecList to the passed in destination
+func GenerateSpecList(report types.Report, dst string) error {
+	return writeReportFile(SpecList(report), dst)
+}
+
+type specListLine struct {
+	location types.CodeLocation
+	line     string
+}
+
+func relativeCodeLocation(root string, cl types.CodeLocation) types.CodeLocation {
+	if root == "" || !filepath.IsAbs(cl.FileName) {
+		return cl
+	}
+	if rel, err := filepath.Rel(root, cl.FileName); err == nil {
+		cl.FileName = filepath.ToSlash(rel)
+	}
+	return cl
+}
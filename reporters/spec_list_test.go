@@ -0,0 +1,67 @@
+package reporters_test
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/onsi/ginkgo/v2/reporters"
+	"github.com/onsi/ginkgo/v2/types"
+)
+
+var _ = Describe("SpecList", func() {
+	var report types.Report
+	absCL := func(file string, line int) types.CodeLocation {
+		return types.CodeLocation{FileName: "/path/to/suite/" + file, LineNumber: line}
+	}
+	relCL := func(file string, line int) types.CodeLocation {
+		return types.CodeLocation{FileName: file, LineNumber: line}
+	}
+
+	BeforeEach(func() {
+		report = types.Report{
+			SuitePath: "/path/to/suite",
+			SpecReports: types.SpecReports{
+				S(types.NodeTypeBeforeSuite, absCL("suite_test.go", 3), types.SpecStatePassed),
+				S(CTS("A"), CLS(absCL("b_test.go", 1)), "C", absCL("b_test.go", 7), types.SpecStateFailed),
+				S(CTS("A"), CLS(absCL("b_test.go", 1)), "B", absCL("b_test.go", 4), types.SpecStatePassed, Labels{"fast"}),
+				S(CTS("D"), CLS(absCL("a_test.go", 1)), "loop 2", absCL("a_test.go", 5), types.SpecStateSkipped),
+				S(CTS("D"), CLS(absCL("a_test.go", 1)), "loop 1", absCL("a_test.go", 5), types.SpecStatePending),
+			},
+		}
+	})
+
+	It("lists every spec, regardless of state, sorted by location with IDs and locations relative to the suite", func() {
+		idA := S(CTS("D"), CLS(relCL("a_test.go", 1)), "loop 1", relCL("a_test.go", 5)).LocationID("")
+		idB := S(CTS("A"), CLS(relCL("b_test.go", 1)), "B", relCL("b_test.go", 4)).LocationID("")
+		idC := S(CTS("A"), CLS(relCL("b_test.go", 1)), "C", relCL("b_test.go", 7)).LocationID("")
+		Ω(reporters.SpecList(report)).Should(Equal(
+			idA + " a_test.go:5 D loop 1\n" +
+				idA + " a_test.go:5 D loop 2\n" +
+				idB + " b_test.go:4 A B [fast]\n" +
+				idC + " b_test.go:7 A C\n",
+		))
+	})
+
+	It("does not depend on the order in which specs were reported", func() {
+		shuffled := types.Report{SuitePath: report.SuitePath}
+		for i := len(report.SpecReports) - 1; i >= 0; i-- {
+			shuffled.SpecReports = append(shuffled.SpecReports, report.SpecReports[i])
+		}
+		Ω(reporters.SpecList(shuffled)).Should(Equal(reporters.SpecList(report)))
+	})
+
+	It("writes the list to disk", func() {
+		folderPath := filepath.Join(fmt.Sprintf("test_outputs_%d", GinkgoParallelProcess()))
+		filePath := filepath.Join(folderPath, "specs.golden")
+		Ω(reporters.GenerateSpecList(report, filePath)).Should(Succeed())
+		DeferCleanup(os.RemoveAll, folderPath)
+
+		content, err := os.ReadFile(filePath)
+		Ω(err).ShouldNot(HaveOccurred())
+		Ω(string(content)).Should(Equal(reporters.SpecList(report)))
+	})
+})
@@ -0,0 +1,49 @@
+package reporters
+
+import (
+	"encoding/json"
+	"os"
+	"time"
+
+	"github.com/onsi/ginkgo/v2/types"
+)
+
+// TimingProfile maps a spec's full text onto how long the spec took to run
+type TimingProfile map[string]time.Duration
+
+/*
+LoadTimingProfile builds a TimingProfile from a JSON report written by a previous run (e.g. with --json-report).
+
+Only specs that ran to completion (i.e. passed or failed) are included.  A JSON report can contain several suites - if specs in different suites share the same full text the last one wins.
+*/
+func LoadTimingProfile(src string) (TimingProfile, error) {
+	data, err := os.ReadFile(src)
+	if err != nil {
+		return nil, err
+	}
+	reports := []types.Report{}
+	if err := json.Unmarshal(data, &reports); err != nil {
+		return nil, err
+	}
+	profile := TimingProfile{}
+	for _, report := range reports {
+		for _, spec := range report.SpecReports.WithLeafNodeType(types.NodeTypeIt) {
+			if spec.State.Is(types.SpecStatePassed | types.SpecStateFailureStates) {
+				profile[spec.FullText()] = spec.RunTime
+			}
+		}
+	}
+	return profile, nil
+}
+
+// Average returns the mean duration of the specs in the profile, or zero if the profile is empty
+func (profile TimingProfile) Average() time.Duration {
+	if len(profile) == 0 {
+		return 0
+	}
+	total := time.Duration(0)
+	for _, duration := range profile {
+		total += duration
+	}
+	return total / time.Duration(len(profile))
+}
@@ -0,0 +1,52 @@
+package reporters_test
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/onsi/ginkgo/v2/reporters"
+	"github.com/onsi/ginkgo/v2/types"
+)
+
+var _ = Describe("TimingProfile", func() {
+	var folderPath string
+
+	BeforeEach(func() {
+		folderPath = filepath.Join(fmt.Sprintf("test_outputs_%d", GinkgoParallelProcess()))
+		DeferCleanup(os.RemoveAll, folderPath)
+	})
+
+	It("loads the run time of every spec that ran from a JSON report", func() {
+		report := types.Report{SpecReports: types.SpecReports{
+			S(types.NodeTypeBeforeSuite, cl0, types.SpecStatePassed, time.Second),
+			S(CTS("A"), "B", cl1, types.SpecStatePassed, 2*time.Second),
+			S(CTS("A"), "C", cl2, types.SpecStateFailed, 4*time.Second),
+			S(CTS("A"), "D", cl3, types.SpecStateSkipped),
+			S(CTS("A"), "E", cl4, types.SpecStatePending),
+		}}
+		filePath := filepath.Join(folderPath, "report.json")
+		Ω(reporters.GenerateJSONReport(report, filePath)).Should(Succeed())
+
+		profile, err := reporters.LoadTimingProfile(filePath)
+		Ω(err).ShouldNot(HaveOccurred())
+		Ω(profile).Should(Equal(reporters.TimingProfile{
+			"A B": 2 * time.Second,
+			"A C": 4 * time.Second,
+		}))
+		Ω(profile.Average()).Should(Equal(3 * time.Second))
+	})
+
+	It("returns an error if the report can't be read", func() {
+		_, err := reporters.LoadTimingProfile(filepath.Join(folderPath, "missing.json"))
+		Ω(err).Should(HaveOccurred())
+	})
+
+	It("has no average when empty", func() {
+		Ω(reporters.TimingProfile{}.Average()).Should(BeZero())
+	})
+})
@@ -154,6 +154,7 @@ func registerReportAfterSuiteNodeForAutogeneratedReports(reporterConfig types.Re
 			if err != nil {
 				Fail(fmt.Sprintf("Failed to generate JUnit report:\n%s", err.Error()))
 			}
+			reporters.CleanupPartialJUnitReports(reporterConfig.JUnitReport)
 		}
 		if reporterConfig.TeamcityReport != "" {
 			err := reporters.GenerateTeamcityReport(report, reporterConfig.TeamcityReport)
@@ -161,6 +162,27 @@ func registerReportAfterSuiteNodeForAutogeneratedReports(reporterConfig types.Re
 				Fail(fmt.Sprintf("Failed to generate Teamcity report:\n%s", err.Error()))
 			}
 		}
+		if reporterConfig.FailedSpecsReport != "" {
+			err := reporters.GenerateFailedSpecsReport(report, reporterConfig.FailedSpecsReport)
+			if err != nil {
+				Fail(fmt.Sprintf("Failed to generate failed specs report:\n%s", err.Error()))
+			}
+		}
+	}
+
+	if reporterConfig.JUnitReport != "" && reporterConfig.RecordPartialJUnitReport {
+		// record each spec as it completes so that the Ginkgo CLI can recover a partial JUnit report if the suite crashes
+		// only the CLI can perform that recovery (and clean up the partial files) so this is skipped under go test
+		pushNode(internal.NewNode(
+			deprecationTracker, types.NodeTypeReportAfterEach, "",
+			func(report SpecReport) {
+				err := reporters.AppendToPartialJUnitReport(report, reporterConfig.JUnitReport, report.ParallelProcess)
+				if err != nil {
+					Fail(fmt.Sprintf("Failed to record partial JUnit report:\n%s", err.Error()))
+				}
+			},
+			types.NewCustomCodeLocation("autogenerated by Ginkgo"),
+		))
 	}
 
 	flags := []string{}
@@ -173,6 +195,9 @@ func registerReportAfterSuiteNodeForAutogeneratedReports(reporterConfig types.Re
 	if reporterConfig.TeamcityReport != "" {
 		flags = append(flags, "--teamcity-report")
 	}
+	if reporterConfig.FailedSpecsReport != "" {
+		flags = append(flags, "--failed-specs-report")
+	}
 	pushNode(internal.NewNode(
 		deprecationTracker, types.NodeTypeReportAfterSuite,
 		fmt.Sprintf("Autogenerated ReportAfterSuite for %s", strings.Join(flags, " ")),
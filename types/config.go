@@ -9,6 +9,7 @@ import (
 	"flag"
 	"os"
 	"path/filepath"
+	"regexp"
 	"runtime"
 	"strconv"
 	"strings"
@@ -17,25 +18,39 @@ import (
 
 // Configuration controlling how an individual test suite is run
 type SuiteConfig struct {
-	RandomSeed            int64
-	RandomizeAllSpecs     bool
-	FocusStrings          []string
-	SkipStrings           []string
-	FocusFiles            []string
-	SkipFiles             []string
-	LabelFilter           string
-	FailOnPending         bool
-	FailFast              bool
-	FlakeAttempts         int
-	MustPassRepeatedly    int
-	DryRun                bool
-	PollProgressAfter     time.Duration
-	PollProgressInterval  time.Duration
-	Timeout               time.Duration
-	EmitSpecProgress      bool // this is deprecated but its removal is causing compile issue for some users that were setting it manually
-	OutputInterceptorMode string
-	SourceRoots           []string
-	GracePeriod           time.Duration
+	RandomSeed                 int64
+	RandomizeAllSpecs          bool
+	OrderByLocation            bool
+	TimingProfileFrom          string
+	FocusStrings               []string
+	SkipStrings                []string
+	FocusFiles                 []string
+	SkipFiles                  []string
+	LabelFilter                string
+	PhaseLabels                []string
+	PhaseContinueOnFailure     bool
+	SmokeLabel                 string
+	FailedSpecsFrom            string
+	FailOnPending              bool
+	FailOnFocused              bool
+	FailFast                   bool
+	ContainerFailureBudget     int
+	FlakeAttempts              int
+	MustPassRepeatedly         int
+	ExpectedSpecCount          int
+	ExpectedSpecCountTolerance int
+	DryRun                     bool
+	PollProgressAfter          time.Duration
+	PollProgressInterval       time.Duration
+	ProgressSummaryInterval    time.Duration
+	Timeout                    time.Duration
+	DefaultSpecTimeout         time.Duration
+	EmitSpecProgress           bool // this is deprecated but its removal is causing compile issue for some users that were setting it manually
+	OutputInterceptorMode      string
+	OutputInterceptorLabel     string
+	RedactOutputPatterns       []string
+	SourceRoots                []string
+	GracePeriod                time.Duration
 
 	ParallelProcess int
 	ParallelTotal   int
@@ -89,10 +104,17 @@ type ReporterConfig struct {
 	VeryVerbose    bool
 	FullTrace      bool
 	ShowNodeEvents bool
+	SilenceSkips   bool
 
-	JSONReport     string
-	JUnitReport    string
-	TeamcityReport string
+	GroupFailureMessages bool
+	PendingAsWarning     bool
+
+	JSONReport        string
+	JUnitReport       string
+	TeamcityReport    string
+	FailedSpecsReport string
+
+	RecordPartialJUnitReport bool
 }
 
 func (rc ReporterConfig) Verbosity() VerbosityLevel {
@@ -107,7 +129,7 @@ func (rc ReporterConfig) Verbosity() VerbosityLevel {
 }
 
 func (rc ReporterConfig) WillGenerateReport() bool {
-	return rc.JSONReport != "" || rc.JUnitReport != "" || rc.TeamcityReport != ""
+	return rc.JSONReport != "" || rc.JUnitReport != "" || rc.TeamcityReport != "" || rc.FailedSpecsReport != ""
 }
 
 func NewDefaultReporterConfig() ReporterConfig {
@@ -134,6 +156,7 @@ type CLIConfig struct {
 	KeepGoing       bool
 	UntilItFails    bool
 	Repeat          int
+	SoakDuration    time.Duration
 	RandomizeSuites bool
 
 	//for watch only
@@ -267,13 +290,25 @@ var SuiteConfigFlags = GinkgoFlags{
 		Usage: "The seed used to randomize the spec suite."},
 	{KeyPath: "S.RandomizeAllSpecs", Name: "randomize-all", SectionKey: "order", DeprecatedName: "randomizeAllSpecs", DeprecatedDocLink: "changed-command-line-flags",
 		Usage: "If set, ginkgo will randomize all specs together.  By default, ginkgo only randomizes the top level Describe, Context and When containers."},
+	{KeyPath: "S.OrderByLocation", Name: "order-by-location", SectionKey: "order",
+		Usage: "If set, ginkgo will not shuffle the top level containers and will instead run specs in the order they appear in your files, sorted by file name and line number.  --randomize-all takes precedence if both are set."},
+	{KeyPath: "S.TimingProfileFrom", Name: "timing-profile-from", SectionKey: "order", UsageArgument: "filename",
+		Usage: "If set, ginkgo will read how long each spec took from the specified JSON report (as generated by --json-report in a previous run) and, when running in parallel, hand out the slowest specs first to balance the time spent on each process.  Specs missing from the report are assumed to take the average time.  If the file is missing or unreadable ginkgo will warn and fall back to CostHint decorators."},
 
 	{KeyPath: "S.FailOnPending", Name: "fail-on-pending", SectionKey: "failure", DeprecatedName: "failOnPending", DeprecatedDocLink: "changed-command-line-flags",
 		Usage: "If set, ginkgo will mark the test suite as failed if any specs are pending."},
+	{KeyPath: "S.FailOnFocused", Name: "fail-on-focused", SectionKey: "failure",
+		Usage: "If set, ginkgo will mark the test suite as failed if any specs are programmatically focused (e.g. with FIt or FDescribe).  Focus applied on the command line with --focus does not trigger this.  Use this on CI to guard against focused specs being committed to source control."},
 	{KeyPath: "S.FailFast", Name: "fail-fast", SectionKey: "failure", DeprecatedName: "failFast", DeprecatedDocLink: "changed-command-line-flags",
 		Usage: "If set, ginkgo will stop running a test suite after a failure occurs."},
+	{KeyPath: "S.ContainerFailureBudget", Name: "container-failure-budget", SectionKey: "failure", UsageDefaultValue: "0 - no budget",
+		Usage: "If set, ginkgo will skip the remaining specs in a top-level container once this many specs in that container have failed.  Specs in other containers continue to run.  When running in parallel the budget applies to each process independently."},
 	{KeyPath: "S.FlakeAttempts", Name: "flake-attempts", SectionKey: "failure", UsageDefaultValue: "0 - failed tests are not retried", DeprecatedName: "flakeAttempts", DeprecatedDocLink: "changed-command-line-flags",
 		Usage: "Make up to this many attempts to run each spec. If any of the attempts succeed, the suite will not be failed."},
+	{KeyPath: "S.ExpectedSpecCount", Name: "expected-spec-count", SectionKey: "failure", UsageDefaultValue: "0 - the number of specs is not checked",
+		Usage: "If set, ginkgo will mark the test suite as failed if it contains fewer than this many specs (less --expected-spec-count-tolerance).  Use this to guard against specs being accidentally deleted or excluded from the build."},
+	{KeyPath: "S.ExpectedSpecCountTolerance", Name: "expected-spec-count-tolerance", SectionKey: "failure", UsageDefaultValue: "0",
+		Usage: "The number of specs the suite may fall short of --expected-spec-count by before the suite is marked as failed."},
 
 	{KeyPath: "S.DryRun", Name: "dry-run", SectionKey: "debug", DeprecatedName: "dryRun", DeprecatedDocLink: "changed-command-line-flags",
 		Usage: "If set, ginkgo will walk the test hierarchy without actually running anything.  Best paired with -v."},
@@ -281,17 +316,33 @@ var SuiteConfigFlags = GinkgoFlags{
 		Usage: "Emit node progress reports periodically if node hasn't completed after this duration."},
 	{KeyPath: "S.PollProgressInterval", Name: "poll-progress-interval", SectionKey: "debug", UsageDefaultValue: "10s",
 		Usage: "The rate at which to emit node progress reports after poll-progress-after has elapsed."},
+	{KeyPath: "S.ProgressSummaryInterval", Name: "progress-summary-interval", SectionKey: "debug", UsageDefaultValue: "0 - no progress summaries are emitted",
+		Usage: "If set, ginkgo will emit a summary of the specs that have completed so far whenever a spec finishes and at least this much time has passed since the previous summary.  Useful as a heartbeat for long-running suites."},
+	{KeyPath: "S.RedactOutputPatterns", Name: "redact-output", SectionKey: "debug", UsageArgument: "regexp",
+		Usage: "If set, ginkgo will replace any captured output (GinkgoWriter and stdout/stderr) that matches the regular expression with [REDACTED] before it is reported.  You can pass multiple --redact-output flags.  Output streamed live in verbose mode is not redacted."},
 	{KeyPath: "S.SourceRoots", Name: "source-root", SectionKey: "debug",
 		Usage: "The location to look for source code when generating progress reports.  You can pass multiple --source-root flags."},
 	{KeyPath: "S.Timeout", Name: "timeout", SectionKey: "debug", UsageDefaultValue: "1h",
 		Usage: "Test suite fails if it does not complete within the specified timeout."},
+	{KeyPath: "S.DefaultSpecTimeout", Name: "default-spec-timeout", SectionKey: "debug", UsageArgument: "duration",
+		Usage: "If set, ginkgo will time out any spec that does not complete within the specified duration.  Specs decorated with SpecTimeout use their own timeout instead."},
 	{KeyPath: "S.GracePeriod", Name: "grace-period", SectionKey: "debug", UsageDefaultValue: "30s",
 		Usage: "When interrupted, Ginkgo will wait for GracePeriod for the current running node to exit before moving on to the next one."},
 	{KeyPath: "S.OutputInterceptorMode", Name: "output-interceptor-mode", SectionKey: "debug", UsageArgument: "dup, swap, or none",
 		Usage: "If set, ginkgo will use the specified output interception strategy when running in parallel.  Defaults to dup on unix and swap on windows."},
+	{KeyPath: "S.OutputInterceptorLabel", Name: "output-interceptor-label", SectionKey: "debug", UsageArgument: "label",
+		Usage: "If set, ginkgo will only intercept stdout/stderr for specs with this label when running in parallel.  Output emitted by other specs is not captured in their reports."},
 
 	{KeyPath: "S.LabelFilter", Name: "label-filter", SectionKey: "filter", UsageArgument: "expression",
 		Usage: "If set, ginkgo will only run specs with labels that match the label-filter.  The passed-in expression can include boolean operations (!, &&, ||, ','), groupings via '()', and regular expressions '/regexp/'.  e.g. '(cat || dog) && !fruit'"},
+	{KeyPath: "S.PhaseLabels", Name: "phase-label", SectionKey: "filter", UsageArgument: "label",
+		Usage: "If set, ginkgo will run specs in phases.  Specs carrying none of the phase labels run first, followed by the specs carrying the first --phase-label, then the second, and so on.  Can be specified multiple times; the order of the flags determines the order of the phases.  If any spec in a phase fails, specs in later phases are skipped.  Not supported when running in parallel."},
+	{KeyPath: "S.PhaseContinueOnFailure", Name: "phase-continue-on-failure", SectionKey: "filter",
+		Usage: "If set, ginkgo will keep running later phases even if an earlier phase failed.  See --phase-label."},
+	{KeyPath: "S.SmokeLabel", Name: "smoke-label", SectionKey: "filter", UsageArgument: "label",
+		Usage: "If set, ginkgo will warn about any top-level containers that do not include at least one spec with this label.  Use this to keep a hand-picked smoke subset of specs honest."},
+	{KeyPath: "S.FailedSpecsFrom", Name: "failed-specs-from", SectionKey: "filter", UsageArgument: "filename",
+		Usage: "If set, ginkgo will only run the specs listed in the specified file (as generated by --failed-specs-report).  If the file is missing or empty ginkgo will warn and run all specs."},
 	{KeyPath: "S.FocusStrings", Name: "focus", SectionKey: "filter",
 		Usage: "If set, ginkgo will only run specs that match this regular expression. Can be specified multiple times, values are ORed."},
 	{KeyPath: "S.SkipStrings", Name: "skip", SectionKey: "filter",
@@ -307,7 +358,7 @@ var SuiteConfigFlags = GinkgoFlags{
 		DeprecatedVersion: "2.5.0", Usage: ".  The functionality provided by --progress was confusing and is no longer needed.  Use --show-node-events instead to see node entry and exit events included in the timeline of failed and verbose specs.  Or you can run with -vv to always see all node events.  Lastly, --poll-progress-after and the PollProgressAfter decorator now provide a better mechanism for debugging specs that tend to get stuck."},
 }
 
-// ParallelConfigFlags provides flags for the Ginkgo test process (not the CLI)
+// ParallelConfigFlags provides low-level flags that the Ginkgo CLI sets for the Ginkgo test process (not the CLI)
 var ParallelConfigFlags = GinkgoFlags{
 	{KeyPath: "S.ParallelProcess", Name: "parallel.process", SectionKey: "low-level-parallel", UsageDefaultValue: "1",
 		Usage: "This worker process's (one-indexed) process number.  For running specs in parallel."},
@@ -315,6 +366,8 @@ var ParallelConfigFlags = GinkgoFlags{
 		Usage: "The total number of worker processes.  For running specs in parallel."},
 	{KeyPath: "S.ParallelHost", Name: "parallel.host", SectionKey: "low-level-parallel", UsageDefaultValue: "set by Ginkgo CLI",
 		Usage: "The address for the server that will synchronize the processes."},
+	{KeyPath: "R.RecordPartialJUnitReport", Name: "partial-junit-report", SectionKey: "low-level-parallel",
+		Usage: "If set alongside --junit-report, each spec is recorded to a partial report file as it completes so that the Ginkgo CLI can recover a JUnit report if the suite crashes."},
 }
 
 // ReporterConfigFlags provides flags for the Ginkgo test process, and CLI
@@ -331,6 +384,12 @@ var ReporterConfigFlags = GinkgoFlags{
 		Usage: "If set, default reporter prints out the full stack trace when a failure occurs"},
 	{KeyPath: "R.ShowNodeEvents", Name: "show-node-events", SectionKey: "output",
 		Usage: "If set, default reporter prints node > Enter and < Exit events when specs fail"},
+	{KeyPath: "R.SilenceSkips", Name: "silence-skips", SectionKey: "output",
+		Usage: "If set, default reporter will not print out skipped specs.  Skipped specs are still counted in the suite summary and included in machine-readable reports."},
+	{KeyPath: "R.GroupFailureMessages", Name: "group-failure-messages", SectionKey: "output",
+		Usage: "If set, default reporter will list each distinct failure message, and the number of specs that failed with it, at the end of the suite."},
+	{KeyPath: "R.PendingAsWarning", Name: "pending-as-warning", SectionKey: "output",
+		Usage: "If set, default reporter will list every pending spec as a warning at the end of the suite.  Unlike --fail-on-pending this does not fail the suite."},
 
 	{KeyPath: "R.JSONReport", Name: "json-report", UsageArgument: "filename.json", SectionKey: "output",
 		Usage: "If set, Ginkgo will generate a JSON-formatted test report at the specified location."},
@@ -338,6 +397,8 @@ var ReporterConfigFlags = GinkgoFlags{
 		Usage: "If set, Ginkgo will generate a conformant junit test report in the specified file."},
 	{KeyPath: "R.TeamcityReport", Name: "teamcity-report", UsageArgument: "filename", SectionKey: "output",
 		Usage: "If set, Ginkgo will generate a Teamcity-formatted test report at the specified location."},
+	{KeyPath: "R.FailedSpecsReport", Name: "failed-specs-report", UsageArgument: "filename", SectionKey: "output",
+		Usage: "If set, Ginkgo will write the full text of every failed spec to the specified file, one per line.  Pass the file to --failed-specs-from to rerun only those specs."},
 
 	{KeyPath: "D.SlowSpecThresholdWithFLoatUnits", DeprecatedName: "slowSpecThreshold", DeprecatedDocLink: "changed--slowspecthreshold",
 		Usage: "use --slow-spec-threshold instead and pass in a duration string (e.g. '5s', not '5.0')"},
@@ -396,6 +457,10 @@ func VetConfig(flagSet GinkgoFlagSet, suiteConfig SuiteConfig, reporterConfig Re
 		errors = append(errors, GinkgoErrors.DryRunInParallelConfiguration())
 	}
 
+	if len(suiteConfig.PhaseLabels) > 0 && suiteConfig.ParallelTotal > 1 {
+		errors = append(errors, GinkgoErrors.PhasesInParallelConfiguration())
+	}
+
 	if suiteConfig.GracePeriod <= 0 {
 		errors = append(errors, GinkgoErrors.GracePeriodCannotBeZero())
 	}
@@ -421,6 +486,13 @@ func VetConfig(flagSet GinkgoFlagSet, suiteConfig SuiteConfig, reporterConfig Re
 		}
 	}
 
+	for _, pattern := range suiteConfig.RedactOutputPatterns {
+		_, err := regexp.Compile(pattern)
+		if err != nil {
+			errors = append(errors, GinkgoErrors.InvalidRedactOutputRegularExpression(pattern, err))
+		}
+	}
+
 	switch strings.ToLower(suiteConfig.OutputInterceptorMode) {
 	case "", "dup", "swap", "none":
 	default:
@@ -482,6 +554,8 @@ var GinkgoCLIRunFlags = GinkgoFlags{
 		Usage: "If set, ginkgo will keep rerunning test suites until a failure occurs."},
 	{KeyPath: "C.Repeat", Name: "repeat", SectionKey: "debug", UsageArgument: "n", UsageDefaultValue: "0 - i.e. no repetition, run only once",
 		Usage: "The number of times to re-run a test-suite.  Useful for debugging flaky tests.  If set to N the suite will be run N+1 times and will be required to pass each time."},
+	{KeyPath: "C.SoakDuration", Name: "soak-duration", SectionKey: "debug", UsageDefaultValue: "0 - i.e. no soaking, run only once",
+		Usage: "If set, ginkgo will keep rerunning test suites until a failure occurs or this much time has elapsed, whichever comes first.  The duration is checked between attempts; an attempt that is in flight when the duration elapses is allowed to finish."},
 	{KeyPath: "C.RandomizeSuites", Name: "randomize-suites", SectionKey: "order", DeprecatedName: "randomizeSuites", DeprecatedDocLink: "changed-command-line-flags",
 		Usage: "If set, ginkgo will randomize the order in which test suites run."},
 }
@@ -582,6 +656,10 @@ func VetAndInitializeCLIAndGoConfig(cliConfig CLIConfig, goFlagsConfig GoFlagsCo
 		errors = append(errors, GinkgoErrors.BothRepeatAndUntilItFails())
 	}
 
+	if cliConfig.SoakDuration > 0 && (cliConfig.Repeat > 0 || cliConfig.UntilItFails) {
+		errors = append(errors, GinkgoErrors.SoakDurationWithRepeatOrUntilItFails())
+	}
+
 	//initialize the output directory
 	if cliConfig.OutputDir != "" {
 		err := os.MkdirAll(cliConfig.OutputDir, 0777)
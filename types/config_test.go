@@ -224,6 +224,16 @@ var _ = Describe("Config", func() {
 			})
 		})
 
+		Describe("validating --redact-output", func() {
+			It("errors if a pattern is not a valid regular expression", func() {
+				suiteConf.RedactOutputPatterns = []string{`token=\w+`, `(unclosed`}
+				errors := types.VetConfig(flagSet, suiteConf, repConf)
+				Ω(errors).Should(HaveLen(1))
+				Ω(errors[0].Error()).Should(ContainSubstring("Invalid Redact Output Regular Expression"))
+				Ω(errors[0].Error()).Should(ContainSubstring("(unclosed"))
+			})
+		})
+
 		Context("when more than one verbosity flag is set", func() {
 			It("errors", func() {
 				repConf.Succinct, repConf.Verbose, repConf.VeryVerbose = true, true, false
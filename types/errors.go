@@ -390,6 +390,15 @@ func (g ginkgoErrors) InvalidFileFilterRegularExpression(filter string, err erro
 	}
 }
 
+/* Redaction Errors */
+func (g ginkgoErrors) InvalidRedactOutputRegularExpression(pattern string, err error) error {
+	return GinkgoError{
+		Heading: "Invalid Redact Output Regular Expression",
+		Message: fmt.Sprintf(`The provided --redact-output pattern: "%s" is an invalid regular expression.  regexp.Compile error: %s`, pattern, err),
+		DocLink: "logging-output",
+	}
+}
+
 /* Label Errors */
 func (g ginkgoErrors) SyntaxErrorParsingLabelFilter(input string, location int, error string) error {
 	var message string
@@ -587,6 +596,13 @@ func (g ginkgoErrors) DryRunInParallelConfiguration() error {
 	}
 }
 
+func (g ginkgoErrors) PhasesInParallelConfiguration() error {
+	return GinkgoError{
+		Heading: "Ginkgo only runs specs in phases in serial mode.",
+		Message: "Please try running ginkgo --phase-label again, but without -p or -procs to ensure the suite is running in series.",
+	}
+}
+
 func (g ginkgoErrors) GracePeriodCannotBeZero() error {
 	return GinkgoError{
 		Heading: "Ginkgo requires a positive --grace-period.",
@@ -629,6 +645,13 @@ func (g ginkgoErrors) BothRepeatAndUntilItFails() error {
 	}
 }
 
+func (g ginkgoErrors) SoakDurationWithRepeatOrUntilItFails() error {
+	return GinkgoError{
+		Heading: "--soak-duration is set alongside --repeat or --until-it-fails",
+		Message: "--soak-duration directs Ginkgo to rerun specs until they fail or the duration elapses.  It can't be combined with --repeat or --until-it-fails... which would you like?",
+	}
+}
+
 /* Stack-Trace parsing errors */
 
 func (g ginkgoErrors) FailedToParseStackTrace(message string) error {
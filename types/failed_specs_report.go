@@ -0,0 +1,26 @@
+package types
+
+import (
+	"os"
+	"strings"
+)
+
+// LoadFailedSpecsReport returns the spec texts recorded in a failed specs report generated by reporters.GenerateFailedSpecsReport
+func LoadFailedSpecsReport(src string) ([]string, error) {
+	data, err := os.ReadFile(src)
+	if err != nil {
+		return nil, err
+	}
+	texts := []string{}
+	for _, line := range strings.Split(string(data), "\n") {
+		if line != "" {
+			texts = append(texts, line)
+		}
+	}
+	return texts, nil
+}
+
+// FailedSpecsReportLine returns the line used to record a spec with the passed in full text in a failed specs report
+func FailedSpecsReportLine(fullText string) string {
+	return strings.ReplaceAll(fullText, "\n", " ")
+}
@@ -0,0 +1,34 @@
+package types_test
+
+import (
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/onsi/ginkgo/v2/types"
+)
+
+var _ = Describe("Failed Specs Reports", func() {
+	var dir string
+
+	BeforeEach(func() {
+		dir = GinkgoT().TempDir()
+	})
+
+	It("loads one spec text per line, ignoring blank lines", func() {
+		src := filepath.Join(dir, "failed.txt")
+		Ω(os.WriteFile(src, []byte("A C\n\nA D E\n"), 0644)).Should(Succeed())
+		Ω(types.LoadFailedSpecsReport(src)).Should(Equal([]string{"A C", "A D E"}))
+	})
+
+	It("errors when loading a report that does not exist", func() {
+		_, err := types.LoadFailedSpecsReport(filepath.Join(dir, "missing.txt"))
+		Ω(err).Should(HaveOccurred())
+	})
+
+	It("records specs with multi-line text on a single line", func() {
+		Ω(types.FailedSpecsReportLine("A\nB C")).Should(Equal("A B C"))
+	})
+})
@@ -22,6 +22,20 @@ func matchLabelAction(label string) LabelFilter {
 	}
 }
 
+/*
+PhaseIndexForLabels returns the phase that a spec with the passed-in labels belongs to, given an ordered list of phase labels.
+
+Specs that carry none of the phase labels belong to phase 0 and run before all named phases.  Otherwise the spec belongs to the latest phase whose label it carries (1 for phaseLabels[0], 2 for phaseLabels[1], etc.).  Labels are matched case-insensitively.
+*/
+func PhaseIndexForLabels(labels []string, phaseLabels []string) int {
+	for idx := len(phaseLabels) - 1; idx >= 0; idx-- {
+		if matchLabelAction(phaseLabels[idx])(labels) {
+			return idx + 1
+		}
+	}
+	return 0
+}
+
 func matchLabelRegexAction(regex *regexp.Regexp) LabelFilter {
 	return func(labels []string) bool {
 		for i := range labels {
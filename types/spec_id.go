@@ -0,0 +1,99 @@
+package types
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"path/filepath"
+	"strings"
+)
+
+/*
+LocationID returns an identifier for the spec that is derived solely from the code locations of the spec's containers and leaf node.
+
+Absolute file names are made relative to suitePath (usually Report.SuitePath) before they are hashed so the LocationID is the same regardless of where the suite is checked out.  Pass an empty suitePath to hash the file names as recorded.
+
+The LocationID does not change when the spec's description (or any of its container's descriptions) is edited.  It does change when the spec is moved - including when lines are added or removed above it in the same file.  Specs that are generated in a loop share a code location and so share a LocationID.
+
+Use ContentHash to detect changes to the body of the spec.
+*/
+func (report SpecReport) LocationID(suitePath string) string {
+	locations := []string{}
+	for _, cl := range append(append([]CodeLocation{}, report.ContainerHierarchyLocations...), report.LeafNodeLocation) {
+		locations = append(locations, fmt.Sprintf("%s:%d", relativeFileName(suitePath, cl.FileName), cl.LineNumber))
+	}
+	return shortHash(strings.Join(locations, "\n"))
+}
+
+func relativeFileName(root string, fileName string) string {
+	if root == "" || !filepath.IsAbs(fileName) {
+		return fileName
+	}
+	if rel, err := filepath.Rel(root, fileName); err == nil {
+		return filepath.ToSlash(rel)
+	}
+	return fileName
+}
+
+/*
+ContentHash returns a hash of the source code of the spec's leaf node, excluding its description.
+
+Go does not provide a way to inspect a compiled closure so ContentHash reads and parses the source file that contains the spec's leaf node and hashes the remaining arguments passed to the leaf node (e.g. the body passed to It or the parameters passed to Entry).  The source is normalized before it is hashed, so whitespace and comment-only changes do not change the hash.  This has some important limitations:
+
+- The source file must be available at the path recorded by the LeafNodeLocation when ContentHash is called.
+- Only the leaf node is hashed.  Changes to setup nodes (e.g. BeforeEach), to the body of a DescribeTable, or to any helper functions, variables, or packages the spec depends on are not detected.
+- Specs that are generated in a loop share a leaf node and so share a ContentHash.
+
+ContentHash returns an error if the source cannot be read or the leaf node cannot be found in it.
+
+Pair ContentHash with LocationID to distinguish a spec that has moved from a spec that has changed.
+*/
+func (report SpecReport) ContentHash() (string, error) {
+	cl := report.LeafNodeLocation
+	if cl.FileName == "" || cl.LineNumber == 0 {
+		return "", fmt.Errorf("spec has no leaf node location")
+	}
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, cl.FileName, nil, 0)
+	if err != nil {
+		return "", err
+	}
+
+	// the leaf node's code location is the line on which the call to the leaf node (e.g. It) begins
+	// the outermost call that begins on that line is the call to the leaf node
+	var leafNodeCall *ast.CallExpr
+	ast.Inspect(file, func(n ast.Node) bool {
+		if leafNodeCall != nil {
+			return false
+		}
+		call, ok := n.(*ast.CallExpr)
+		if ok && fset.Position(call.Pos()).Line == cl.LineNumber {
+			leafNodeCall = call
+			return false
+		}
+		return true
+	})
+	if leafNodeCall == nil {
+		return "", fmt.Errorf("could not find the leaf node at %s", cl)
+	}
+
+	content := &strings.Builder{}
+	if len(leafNodeCall.Args) > 1 {
+		for _, arg := range leafNodeCall.Args[1:] {
+			err := printer.Fprint(content, fset, arg)
+			if err != nil {
+				return "", err
+			}
+			content.WriteString("\n")
+		}
+	}
+	// printing the arguments normalizes their formatting, collapsing whitespace takes care of any blank lines left behind by comments
+	return shortHash(strings.Join(strings.Fields(content.String()), " ")), nil
+}
+
+func shortHash(s string) string {
+	return fmt.Sprintf("%x", sha256.Sum256([]byte(s)))[:16]
+}
@@ -0,0 +1,112 @@
+package types_test
+
+import (
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/onsi/ginkgo/v2/types"
+)
+
+var _ = Describe("Spec IDs", func() {
+	var fileName string
+
+	writeSource := func(src string) {
+		Ω(os.WriteFile(fileName, []byte(src), 0644)).Should(Succeed())
+	}
+
+	reportAt := func(line int) types.SpecReport {
+		return types.SpecReport{
+			ContainerHierarchyLocations: []types.CodeLocation{{FileName: fileName, LineNumber: 3}},
+			LeafNodeText:                "is a spec",
+			LeafNodeLocation:            types.CodeLocation{FileName: fileName, LineNumber: line},
+		}
+	}
+
+	BeforeEach(func() {
+		fileName = filepath.Join(GinkgoT().TempDir(), "fixture_test.go")
+		writeSource(`package fixture_test
+
+var _ = Describe("container", func() {
+	It("is a spec", func() {
+		Expect(1).To(Equal(1))
+	})
+
+	It("is another spec", func() { Expect(2).To(Equal(2)) })
+})
+`)
+	})
+
+	Describe("LocationID", func() {
+		It("depends only on the code locations of the spec", func() {
+			report := reportAt(4)
+			id := report.LocationID("")
+			Ω(id).Should(HaveLen(16))
+
+			report.LeafNodeText = "has a new description"
+			report.ContainerHierarchyTexts = []string{"a new container"}
+			Ω(report.LocationID("")).Should(Equal(id))
+
+			Ω(reportAt(8).LocationID("")).ShouldNot(Equal(id))
+		})
+
+		It("is the same for suites checked out under different roots", func() {
+			reportUnder := func(root string) types.SpecReport {
+				return types.SpecReport{
+					ContainerHierarchyLocations: []types.CodeLocation{{FileName: root + "/suite/a_test.go", LineNumber: 3}},
+					LeafNodeLocation:            types.CodeLocation{FileName: root + "/suite/a_test.go", LineNumber: 4},
+				}
+			}
+			id := reportUnder("/checkout/one").LocationID("/checkout/one/suite")
+			Ω(reportUnder("/ci/workspace").LocationID("/ci/workspace/suite")).Should(Equal(id))
+			Ω(reportUnder("/ci/workspace").LocationID("")).ShouldNot(Equal(id))
+		})
+	})
+
+	Describe("ContentHash", func() {
+		It("changes when the body changes but not when the description, formatting, or comments change", func() {
+			hash, err := reportAt(4).ContentHash()
+			Ω(err).ShouldNot(HaveOccurred())
+
+			otherHash, err := reportAt(8).ContentHash()
+			Ω(err).ShouldNot(HaveOccurred())
+			Ω(otherHash).ShouldNot(Equal(hash))
+
+			writeSource(`package fixture_test
+
+var _ = Describe("container", func() {
+	It("has been renamed", func() {
+		// a comment
+		Expect(1).To(Equal( 1 ))
+	})
+})
+`)
+			Ω(reportAt(4).ContentHash()).Should(Equal(hash))
+
+			writeSource(`package fixture_test
+
+var _ = Describe("container", func() {
+	It("is a spec", func() {
+		Expect(1).To(Equal(2))
+	})
+})
+`)
+			Ω(reportAt(4).ContentHash()).ShouldNot(Equal(hash))
+		})
+
+		It("errors when the leaf node cannot be found", func() {
+			_, err := reportAt(2).ContentHash()
+			Ω(err).Should(HaveOccurred())
+
+			_, err = types.SpecReport{}.ContentHash()
+			Ω(err).Should(HaveOccurred())
+
+			report := reportAt(4)
+			report.LeafNodeLocation.FileName = filepath.Join(filepath.Dir(fileName), "missing.go")
+			_, err = report.ContentHash()
+			Ω(err).Should(HaveOccurred())
+		})
+	})
+})
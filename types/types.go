@@ -107,6 +107,32 @@ func (report Report) Add(other Report) Report {
 	return report
 }
 
+// ParallelProcessRunTimes returns the total time each parallel process spent running specs and suite-level nodes, keyed by process number.
+//
+// This is computed from the ParallelProcess and RunTime of each SpecReport and so works on the aggregated report produced when running in parallel.
+func (report Report) ParallelProcessRunTimes() map[int]time.Duration {
+	runTimes := map[int]time.Duration{}
+	for _, spec := range report.SpecReports {
+		if spec.ParallelProcess == 0 {
+			continue
+		}
+		runTimes[spec.ParallelProcess] += spec.RunTime
+	}
+	return runTimes
+}
+
+// SlowestParallelProcess returns the parallel process that spent the most time running specs along with that time.
+// It returns 0 if no process ran any specs.
+func (report Report) SlowestParallelProcess() (int, time.Duration) {
+	slowest, slowestRunTime := 0, time.Duration(0)
+	for process, runTime := range report.ParallelProcessRunTimes() {
+		if runTime > slowestRunTime || (runTime == slowestRunTime && process < slowest) {
+			slowest, slowestRunTime = process, runTime
+		}
+	}
+	return slowest, slowestRunTime
+}
+
 // SpecReport captures information about a Ginkgo spec.
 type SpecReport struct {
 	// ContainerHierarchyTexts is a slice containing the text strings of
@@ -132,12 +158,21 @@ type SpecReport struct {
 	// State captures whether the spec has passed, failed, etc.
 	State SpecState
 
+	// SkipReason explains why a spec was skipped before it ran - e.g. because it does not match --focus or because the suite was interrupted
+	// It is empty when the spec wasn't skipped or when Failure.Message already explains why (e.g. when Skip() is called)
+	SkipReason string
+
 	// IsSerial captures whether the spec has the Serial decorator
 	IsSerial bool
 
 	// IsInOrderedContainer captures whether the spec appears in an Ordered container
 	IsInOrderedContainer bool
 
+	// PhaseLabel captures the phase the spec ran in when the suite is split into phases (see SuiteConfig.PhaseLabels)
+	// Specs in an Ordered container run in the latest phase of any spec in the container, so this can differ from the spec's own labels
+	// It is empty for specs that ran before any of the labeled phases
+	PhaseLabel string
+
 	// StartTime and EndTime capture the start and end time of the spec
 	StartTime time.Time
 	EndTime   time.Time
@@ -198,6 +233,8 @@ func (report SpecReport) MarshalJSON() ([]byte, error) {
 		LeafNodeLabels              []string
 		LeafNodeText                string
 		State                       SpecState
+		SkipReason                  string `json:",omitempty"`
+		PhaseLabel                  string `json:",omitempty"`
 		StartTime                   time.Time
 		EndTime                     time.Time
 		RunTime                     time.Duration
@@ -221,6 +258,8 @@ func (report SpecReport) MarshalJSON() ([]byte, error) {
 		LeafNodeLabels:              report.LeafNodeLabels,
 		LeafNodeText:                report.LeafNodeText,
 		State:                       report.State,
+		SkipReason:                  report.SkipReason,
+		PhaseLabel:                  report.PhaseLabel,
 		StartTime:                   report.StartTime,
 		EndTime:                     report.EndTime,
 		RunTime:                     report.RunTime,
@@ -304,6 +343,20 @@ func (report SpecReport) Labels() []string {
 	return out
 }
 
+// Phase returns the label of the phase the spec belongs to, or "" if the spec belongs to none of the passed in phases
+// This is the phase the spec ran in (PhaseLabel) when it is set, otherwise the latest phase label the spec carries
+// See SuiteConfig.PhaseLabels for details
+func (report SpecReport) Phase(phaseLabels []string) string {
+	if report.PhaseLabel != "" {
+		return report.PhaseLabel
+	}
+	idx := PhaseIndexForLabels(report.Labels(), phaseLabels)
+	if idx == 0 {
+		return ""
+	}
+	return phaseLabels[idx-1]
+}
+
 // MatchesLabelFilter returns true if the spec satisfies the passed in label filter query
 func (report SpecReport) MatchesLabelFilter(query string) (bool, error) {
 	filter, err := ParseLabelFilter(query)
@@ -399,6 +452,121 @@ func (reports SpecReports) WithState(states SpecState) SpecReports {
 	return out
 }
 
+// PendingSpecTexts returns the full text of every pending spec.  It is the same list that --pending-as-warning prints at the end of the suite.
+func (reports SpecReports) PendingSpecTexts() []string {
+	out := []string{}
+	for _, report := range reports.WithState(SpecStatePending) {
+		out = append(out, report.FullText())
+	}
+	return out
+}
+
+// InExecutionOrder returns a copy of the SpecReports sorted by StartTime.  This is the order in which the specs ran (when running in parallel, across all processes).
+//
+// Pair it with WithState (e.g. WithState(SpecStatePassed|SpecStateFailureStates)) to focus on the specs that actually ran.
+func (reports SpecReports) InExecutionOrder() SpecReports {
+	out := make(SpecReports, len(reports))
+	copy(out, reports)
+	sort.SliceStable(out, func(i, j int) bool {
+		return out[i].StartTime.Before(out[j].StartTime)
+	})
+	return out
+}
+
+// TopLevelContainer identifies a top-level container.  See SpecReports.TopLevelContainersWithoutLabel
+type TopLevelContainer struct {
+	Text         string
+	CodeLocation CodeLocation
+}
+
+/*
+TopLevelContainersWithoutLabel returns the top-level containers that do not contain any spec with the passed-in label, in the order they first appear in the SpecReports.  Labels are compared case-insensitively and labels inherited from containers count.
+
+Specs that are not in a container are ignored.  Specs that were filtered out of the run still appear in the SpecReports (as skipped specs), so the check covers the entire suite regardless of the filters in use.
+*/
+func (reports SpecReports) TopLevelContainersWithoutLabel(label string) []TopLevelContainer {
+	containers := []TopLevelContainer{}
+	covered := map[CodeLocation]bool{}
+	for _, report := range reports.WithLeafNodeType(NodeTypeIt) {
+		if len(report.ContainerHierarchyLocations) == 0 {
+			continue
+		}
+		cl := report.ContainerHierarchyLocations[0]
+		if _, seen := covered[cl]; !seen {
+			covered[cl] = false
+			containers = append(containers, TopLevelContainer{Text: report.ContainerHierarchyTexts[0], CodeLocation: cl})
+		}
+		for _, specLabel := range report.Labels() {
+			if strings.EqualFold(specLabel, label) {
+				covered[cl] = true
+			}
+		}
+	}
+
+	out := []TopLevelContainer{}
+	for _, container := range containers {
+		if !covered[container.CodeLocation] {
+			out = append(out, container)
+		}
+	}
+	return out
+}
+
+// FailureMessageGroup captures a distinct failure message and the specs that failed with it.  See SpecReports.GroupFailuresByMessage
+type FailureMessageGroup struct {
+	// Message is the normalized failure message
+	Message string
+	// SpecReports are the reports for the specs that failed with Message
+	SpecReports SpecReports
+}
+
+/*
+GroupFailuresByMessage groups the failing SpecReports by their failure message.  This makes it easy to spot a single root cause that has caused many specs to fail.
+
+Messages are normalized by collapsing whitespace and, for panics, include the forwarded panic value.  A spec's AdditionalFailures are taken into account so a spec can appear in multiple groups (but only once per group).  The "Failure recorded during attempt N" prefix Ginkgo adds to the failures of earlier attempts of a retried spec is ignored, so a flaky spec that fails the same way on every attempt appears in a single group.
+
+Groups are sorted by the number of specs in the group.  Groups with the same number of specs retain the order in which their messages first appeared.  Use SpecReport.LocationID(report.SuitePath) to get a stable identifier for each spec in a group.
+*/
+func (reports SpecReports) GroupFailuresByMessage() []FailureMessageGroup {
+	groups := []FailureMessageGroup{}
+	groupIndices := map[string]int{}
+	for _, report := range reports.WithState(SpecStateFailureStates) {
+		failures := []Failure{report.Failure}
+		for _, additionalFailure := range report.AdditionalFailures {
+			failures = append(failures, additionalFailure.Failure)
+		}
+		seen := map[string]bool{}
+		for _, failure := range failures {
+			message := failure.Message
+			// failures from earlier attempts of a retried spec are prefixed with the attempt number - strip it so every attempt lands in the same group
+			if strings.HasPrefix(message, "Failure recorded during attempt ") {
+				if _, rest, found := strings.Cut(message, "\n"); found {
+					message = rest
+				}
+			}
+			if failure.ForwardedPanic != "" {
+				message += " " + failure.ForwardedPanic
+			}
+			message = strings.Join(strings.Fields(message), " ")
+			if seen[message] {
+				continue
+			}
+			seen[message] = true
+			idx, ok := groupIndices[message]
+			if !ok {
+				idx = len(groups)
+				groupIndices[message] = idx
+				groups = append(groups, FailureMessageGroup{Message: message})
+			}
+			groups[idx].SpecReports = append(groups[idx].SpecReports, report)
+		}
+	}
+	sort.SliceStable(groups, func(i, j int) bool {
+		return len(groups[i].SpecReports) > len(groups[j].SpecReports)
+	})
+	return groups
+}
+
 // CountWithState returns the number of SpecReports with State matching one of the requested SpecStates
 func (reports SpecReports) CountWithState(states SpecState) int {
 	n := 0
@@ -58,6 +58,28 @@ var _ = Describe("Types", func() {
 
 			})
 		})
+
+		Describe("ParallelProcessRunTimes and SlowestParallelProcess", func() {
+			It("totals the runtime of each parallel process and identifies the slowest one", func() {
+				report := types.Report{
+					SpecReports: types.SpecReports{
+						types.SpecReport{ParallelProcess: 1, RunTime: time.Second},
+						types.SpecReport{ParallelProcess: 2, RunTime: 2 * time.Second},
+						types.SpecReport{ParallelProcess: 1, RunTime: 3 * time.Second},
+						types.SpecReport{ParallelProcess: 3, RunTime: 4 * time.Second},
+					},
+				}
+				Ω(report.ParallelProcessRunTimes()).Should(Equal(map[int]time.Duration{1: 4 * time.Second, 2: 2 * time.Second, 3: 4 * time.Second}))
+
+				process, runTime := report.SlowestParallelProcess()
+				Ω(process).Should(Equal(1))
+				Ω(runTime).Should(Equal(4 * time.Second))
+
+				process, runTime = types.Report{}.SlowestParallelProcess()
+				Ω(process).Should(Equal(0))
+				Ω(runTime).Should(BeZero())
+			})
+		})
 	})
 
 	Describe("ProgressReport", func() {
@@ -234,6 +256,21 @@ var _ = Describe("Types", func() {
 			})
 		})
 
+		Describe("Phase", func() {
+			phaseLabels := []string{"unit", "integration"}
+
+			It("returns the latest phase label the spec carries", func() {
+				Ω(types.SpecReport{}.Phase(phaseLabels)).Should(Equal(""))
+				Ω(types.SpecReport{LeafNodeLabels: []string{"UNIT"}}.Phase(phaseLabels)).Should(Equal("unit"))
+				Ω(types.SpecReport{ContainerHierarchyLabels: [][]string{{"integration"}}, LeafNodeLabels: []string{"unit"}}.Phase(phaseLabels)).Should(Equal("integration"))
+			})
+
+			It("prefers the phase the spec ran in", func() {
+				Ω(types.SpecReport{PhaseLabel: "integration"}.Phase(phaseLabels)).Should(Equal("integration"))
+				Ω(types.SpecReport{LeafNodeLabels: []string{"unit"}, PhaseLabel: "integration"}.Phase(phaseLabels)).Should(Equal("integration"))
+			})
+		})
+
 		It("can report on whether state is a failed state", func() {
 			Ω(types.SpecReport{State: types.SpecStatePending}.Failed()).Should(BeFalse())
 			Ω(types.SpecReport{State: types.SpecStateSkipped}.Failed()).Should(BeFalse())
@@ -371,6 +408,20 @@ var _ = Describe("Types", func() {
 			})
 		})
 
+		Describe("PendingSpecTexts", func() {
+			It("returns the full text of the pending specs", func() {
+				reports := types.SpecReports{
+					{ContainerHierarchyTexts: []string{"A"}, LeafNodeText: "passes", State: types.SpecStatePassed},
+					{ContainerHierarchyTexts: []string{"A"}, LeafNodeText: "is pending", State: types.SpecStatePending},
+					{LeafNodeText: "is skipped", State: types.SpecStateSkipped},
+					{ContainerHierarchyTexts: []string{"B", "C"}, LeafNodeText: "is also pending", State: types.SpecStatePending},
+				}
+
+				Ω(reports.PendingSpecTexts()).Should(Equal([]string{"A is pending", "B C is also pending"}))
+				Ω(types.SpecReports{}.PendingSpecTexts()).Should(BeEmpty())
+			})
+		})
+
 		Describe("CountWithState", func() {
 			It("returns the number with the matching SpecStates", func() {
 				reports := types.SpecReports{
@@ -385,6 +436,93 @@ var _ = Describe("Types", func() {
 			})
 		})
 
+		Describe("InExecutionOrder", func() {
+			It("returns a copy of the reports sorted by StartTime", func() {
+				t := time.Now()
+				reports := types.SpecReports{
+					{LeafNodeText: "C", StartTime: t.Add(2 * time.Second)},
+					{LeafNodeText: "A", StartTime: t},
+					{LeafNodeText: "D", StartTime: t.Add(2 * time.Second)},
+					{LeafNodeText: "B", StartTime: t.Add(time.Second)},
+				}
+
+				ordered := reports.InExecutionOrder()
+				texts := []string{}
+				for _, report := range ordered {
+					texts = append(texts, report.LeafNodeText)
+				}
+				Ω(texts).Should(Equal([]string{"A", "B", "C", "D"}))
+				Ω(reports[0].LeafNodeText).Should(Equal("C"))
+			})
+		})
+
+		Describe("TopLevelContainersWithoutLabel", func() {
+			It("returns the top-level containers that have no specs with the label", func() {
+				clA, clB, clC := types.CodeLocation{FileName: "a.go", LineNumber: 1}, types.CodeLocation{FileName: "b.go", LineNumber: 1}, types.CodeLocation{FileName: "c.go", LineNumber: 1}
+				reports := types.SpecReports{
+					{LeafNodeType: types.NodeTypeBeforeSuite},
+					{LeafNodeType: types.NodeTypeIt, LeafNodeText: "top-level spec"},
+					{LeafNodeType: types.NodeTypeIt, ContainerHierarchyTexts: []string{"A"}, ContainerHierarchyLocations: []types.CodeLocation{clA}},
+					{LeafNodeType: types.NodeTypeIt, ContainerHierarchyTexts: []string{"B", "nested"}, ContainerHierarchyLocations: []types.CodeLocation{clB, clC},
+						ContainerHierarchyLabels: [][]string{{}, {"Smoke"}}, State: types.SpecStateSkipped},
+					{LeafNodeType: types.NodeTypeIt, ContainerHierarchyTexts: []string{"A"}, ContainerHierarchyLocations: []types.CodeLocation{clA}, LeafNodeLabels: []string{"slow"}},
+					{LeafNodeType: types.NodeTypeIt, ContainerHierarchyTexts: []string{"C"}, ContainerHierarchyLocations: []types.CodeLocation{clC}, LeafNodeLabels: []string{"smoke"}},
+				}
+
+				Ω(reports.TopLevelContainersWithoutLabel("smoke")).Should(Equal([]types.TopLevelContainer{{Text: "A", CodeLocation: clA}}))
+				Ω(reports.TopLevelContainersWithoutLabel("slow")).Should(Equal([]types.TopLevelContainer{{Text: "B", CodeLocation: clB}, {Text: "C", CodeLocation: clC}}))
+			})
+		})
+
+		Describe("GroupFailuresByMessage", func() {
+			It("groups failing specs by their normalized failure message, most common first", func() {
+				reports := types.SpecReports{
+					{LeafNodeText: "A", State: types.SpecStateFailed, Failure: types.Failure{Message: "boom"}},
+					{LeafNodeText: "B", State: types.SpecStateFailed, Failure: types.Failure{Message: "expected\n  true"}},
+					{LeafNodeText: "C", State: types.SpecStatePassed},
+					{LeafNodeText: "D", State: types.SpecStateFailed, Failure: types.Failure{Message: "expected true "},
+						AdditionalFailures: []types.AdditionalFailure{
+							{State: types.SpecStateFailed, Failure: types.Failure{Message: "boom"}},
+							{State: types.SpecStateFailed, Failure: types.Failure{Message: "expected true"}},
+						}},
+					{LeafNodeText: "E", State: types.SpecStatePanicked, Failure: types.Failure{Message: "Test Panicked", ForwardedPanic: "bam"}},
+					{LeafNodeText: "F", State: types.SpecStateTimedout, Failure: types.Failure{Message: "expected true"}},
+				}
+
+				texts := func(reports types.SpecReports) []string {
+					out := []string{}
+					for _, report := range reports {
+						out = append(out, report.LeafNodeText)
+					}
+					return out
+				}
+
+				groups := reports.GroupFailuresByMessage()
+				Ω(groups).Should(HaveLen(3))
+				Ω(groups[0].Message).Should(Equal("expected true"))
+				Ω(texts(groups[0].SpecReports)).Should(Equal([]string{"B", "D", "F"}))
+				Ω(groups[1].Message).Should(Equal("boom"))
+				Ω(texts(groups[1].SpecReports)).Should(Equal([]string{"A", "D"}))
+				Ω(groups[2].Message).Should(Equal("Test Panicked bam"))
+				Ω(texts(groups[2].SpecReports)).Should(Equal([]string{"E"}))
+			})
+
+			It("ignores the attempt prefix on failures recorded during earlier attempts of a retried spec", func() {
+				reports := types.SpecReports{
+					{LeafNodeText: "A", State: types.SpecStateFailed, Failure: types.Failure{Message: "boom"}, NumAttempts: 3, MaxFlakeAttempts: 3,
+						AdditionalFailures: []types.AdditionalFailure{
+							{State: types.SpecStateFailed, Failure: types.Failure{Message: "Failure recorded during attempt 1:\nboom"}},
+							{State: types.SpecStateFailed, Failure: types.Failure{Message: "Failure recorded during attempt 2:\nboom"}},
+						}},
+				}
+
+				groups := reports.GroupFailuresByMessage()
+				Ω(groups).Should(HaveLen(1))
+				Ω(groups[0].Message).Should(Equal("boom"))
+				Ω(groups[0].SpecReports).Should(HaveLen(1))
+			})
+		})
+
 		Describe("CountOfFlakedSpecs", func() {
 			It("returns the number of passing specs with NumAttempts > 1", func() {
 				reports := types.SpecReports{